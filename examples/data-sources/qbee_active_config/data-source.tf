@@ -0,0 +1,19 @@
+# Read the configuration of a node, merged with the configuration of its groups
+data "qbee_active_config" "device" {
+  node = "example-node-id"
+}
+
+output "device_ntp_servers" {
+  value = data.qbee_active_config.device.bundle_data.ntp.servers
+}
+
+# Read only the configuration set on a tag, as JSON
+data "qbee_active_config" "tag" {
+  tag   = "example-tag"
+  scope = "own"
+}
+
+output "tag_bundles" {
+  value     = { for name, data in data.qbee_active_config.tag.bundles_json : name => jsondecode(data) }
+  sensitive = true
+}
@@ -0,0 +1,16 @@
+# The commits changing the configuration of a device in the last week
+data "qbee_config_commits" "device" {
+  node  = "example-node-id"
+  since = timeadd(plantimestamp(), "-168h")
+}
+
+# The last 10 commits made by Terraform for a pull request
+data "qbee_config_commits" "pull_request" {
+  author           = "terraform@example.com"
+  message_contains = "PR-1234"
+  limit            = 10
+}
+
+output "device_changes" {
+  value = [for commit in data.qbee_config_commits.device.commits : "${commit.sha}: ${join(", ", commit.bundles)}"]
+}
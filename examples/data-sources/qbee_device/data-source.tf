@@ -0,0 +1,7 @@
+data "qbee_device" "example" {
+  node_id = "example-node-id"
+}
+
+output "example_agent_version" {
+  value = data.qbee_device.example.agent_version
+}
@@ -0,0 +1,10 @@
+data "qbee_device_inventory" "example" {
+  node_id = "example-node-id"
+}
+
+# Choose the RAUC bundle matching the architecture of the device
+resource "qbee_rauc" "example" {
+  node        = data.qbee_device_inventory.example.node_id
+  extend      = true
+  rauc_bundle = "/rauc/${data.qbee_device_inventory.example.architecture}/bundle.raucb"
+}
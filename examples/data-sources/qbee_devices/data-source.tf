@@ -0,0 +1,21 @@
+# All online gateways in the nordics group or any of its subgroups
+data "qbee_devices" "gateways" {
+  tag        = "gateway"
+  group_id   = "nordics"
+  name_regex = "^gw-"
+  online     = true
+}
+
+# One parameters resource per device
+resource "qbee_parameters" "gateway" {
+  for_each = toset(data.qbee_devices.gateways.node_ids)
+
+  node   = each.value
+  extend = true
+  parameters = [
+    {
+      key   = "site"
+      value = "nordics"
+    }
+  ]
+}
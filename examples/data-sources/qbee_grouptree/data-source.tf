@@ -0,0 +1,11 @@
+# The whole group tree
+data "qbee_grouptree" "all" {}
+
+# The subtree below a group
+data "qbee_grouptree" "europe" {
+  node_id = "europe"
+}
+
+output "europe_groups" {
+  value = [for node in data.qbee_grouptree.europe.nodes : node.path if node.type == "group"]
+}
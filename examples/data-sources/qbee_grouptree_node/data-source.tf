@@ -0,0 +1,9 @@
+data "qbee_grouptree_node" "oslo" {
+  path = "root/EU/Oslo"
+}
+
+resource "qbee_grouptree_group" "oslo_gateways" {
+  id       = "oslo-gateways"
+  title    = "Gateways"
+  ancestor = data.qbee_grouptree_node.oslo.node_id
+}
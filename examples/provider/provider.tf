@@ -2,4 +2,27 @@ provider "qbee" {
   username = "qbee@example.com"
   password = "test123"
   base_url = "https://www.app.qbee.io"
+
+  default_timeouts = {
+    create = "10m"
+    delete = "5m"
+  }
+}
+
+# Alternatively, authenticate with a pre-issued API token instead of a username and password.
+provider "qbee" {
+  alias     = "token"
+  api_token = "qbee-api-token"
+}
+
+# Or read the credentials from a named profile of the credentials file in ~/.config/qbee/credentials:
+#
+#   [production]
+#   api_token = qbee-api-token
+#   base_url  = https://www.app.qbee.io
+#
+# Settings in the provider block take precedence over environment variables, which take precedence over the profile.
+provider "qbee" {
+  alias   = "production"
+  profile = "production"
 }
@@ -0,0 +1,5 @@
+# qbee_configuration_bundle can be imported by specifying the bundle name, followed by a colon,
+# the type (tag or node), followed by a colon, and finally either the tag or the node id.
+
+terraform import qbee_configuration_bundle.example_tag connectivity_watchdog:tag:example-tag
+terraform import qbee_configuration_bundle.example_node ntp:node:example-node-id
@@ -0,0 +1,21 @@
+resource "qbee_configuration_bundle" "example_tag" {
+  bundle = "connectivity_watchdog"
+  tag    = "example-tag"
+  extend = true
+
+  content = jsonencode({
+    threshold = "5"
+  })
+}
+
+resource "qbee_configuration_bundle" "example_node" {
+  bundle = "ntp"
+  node   = "example-node-id"
+  extend = true
+
+  content = jsonencode({
+    servers = [
+      { host = "pool.ntp.org" },
+    ]
+  })
+}
@@ -0,0 +1,2 @@
+# qbee_device_attributes can be imported by specifying the node id of the device.
+terraform import qbee_device_attributes.example example-node-id
@@ -0,0 +1,11 @@
+resource "qbee_device_attributes" "example" {
+  node_id     = "example-node-id"
+  device_name = "Gateway Oslo"
+  description = "Gateway in the server room"
+  address     = "Karl Johans gate 1"
+  zip         = "0154"
+  city        = "Oslo"
+  country     = "Norway"
+  latitude    = 59.9139
+  longitude   = 10.7522
+}
@@ -0,0 +1,38 @@
+resource "qbee_docker_compose" "example_tag" {
+  tag    = "example-tag"
+  extend = true
+  clean  = true
+  projects = [
+    {
+      name          = "webapp"
+      file          = "/compose/webapp/compose.yml"
+      context       = "/compose/webapp/context.tar.gz"
+      use_context   = true
+      pre_condition = "true"
+      parameters = [
+        {
+          key   = "version"
+          value = "1.2.3"
+        }
+      ]
+    }
+  ]
+  registry_auths = [
+    {
+      server   = "registry.example.com"
+      username = "user"
+      password = "password"
+    }
+  ]
+}
+
+resource "qbee_docker_compose" "example_node" {
+  node   = "example_node"
+  extend = true
+  projects = [
+    {
+      name = "monitoring"
+      file = "/compose/monitoring/compose.yml"
+    }
+  ]
+}
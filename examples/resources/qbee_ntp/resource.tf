@@ -0,0 +1,12 @@
+resource "qbee_ntp" "example_tag" {
+  tag       = "example-tag"
+  extend    = true
+  servers   = ["0.pool.ntp.org", "1.pool.ntp.org"]
+  time_zone = "Europe/Oslo"
+}
+
+resource "qbee_ntp" "example_node" {
+  node    = "example-node-id"
+  extend  = true
+  servers = ["time.example.com"]
+}
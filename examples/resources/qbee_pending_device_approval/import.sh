@@ -0,0 +1,2 @@
+# qbee_pending_device_approval can be imported by specifying the node id of an approved device.
+terraform import qbee_pending_device_approval.example example-node-id
@@ -0,0 +1,14 @@
+# Approve a device by its public key fingerprint, and move it to a group
+resource "qbee_pending_device_approval" "example" {
+  pub_key_digest = "example-public-key-digest"
+  group_id       = "production"
+
+  # Remove the device from qbee when the resource is destroyed
+  remove_on_destroy = true
+}
+
+# Reject a device by its node ID
+resource "qbee_pending_device_approval" "rejected" {
+  node_id = "example-node-id"
+  approve = false
+}
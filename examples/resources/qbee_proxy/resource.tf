@@ -0,0 +1,18 @@
+resource "qbee_proxy" "example_tag" {
+  tag    = "example-tag"
+  extend = true
+  host   = "proxy.example.com"
+  port   = 3128
+}
+
+resource "qbee_proxy" "example_node" {
+  node   = "example-node-id"
+  extend = true
+  host   = "proxy.example.com"
+  port   = 3128
+  user   = "qbee"
+
+  # The password is write-only, and is only updated when the version changes
+  password_wo         = "proxy-password"
+  password_wo_version = 1
+}
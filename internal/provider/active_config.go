@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"go.qbee.io/client/config"
+)
+
+// activeConfigPath is the path of the active configuration, followed by the entity type, ID and scope.
+const activeConfigPath = "/api/v2/config/"
+
+// rawActiveConfig is the active configuration of a node or tag, with the data of each bundle kept as
+// returned by the API. Unlike config.Config, it includes bundles and keys unknown to the API client.
+type rawActiveConfig struct {
+	Bundles    []config.Bundle            `json:"bundles"`
+	BundleData map[string]json.RawMessage `json:"bundle_data"`
+}
+
+// getRawActiveConfig returns the active configuration of the entity in the given scope.
+func (cli *Client) getRawActiveConfig(
+	ctx context.Context,
+	entityType config.EntityType,
+	entityID string,
+	scope config.EntityConfigScope,
+) (*rawActiveConfig, error) {
+	activeConfig := new(rawActiveConfig)
+
+	path := activeConfigPath + string(entityType) + "/" + url.PathEscape(entityID) + "/" + string(scope)
+	if err := cli.Call(ctx, http.MethodGet, path, nil, activeConfig); err != nil {
+		return nil, err
+	}
+
+	return activeConfig, nil
+}
+
+// typed returns the active configuration decoded by the API client, which leaves out bundles and keys it
+// doesn't know.
+func (c *rawActiveConfig) typed() (*config.Config, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	activeConfig := new(config.Config)
+	if err := json.Unmarshal(data, activeConfig); err != nil {
+		return nil, err
+	}
+
+	return activeConfig, nil
+}
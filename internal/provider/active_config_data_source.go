@@ -0,0 +1,420 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	resourcetimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.qbee.io/client/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &activeConfigDataSource{}
+	_ datasource.DataSourceWithConfigure        = &activeConfigDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &activeConfigDataSource{}
+)
+
+const (
+	errorReadingActiveConfig = "error reading active configuration"
+
+	// activeConfigScopeOwn selects the configuration set on the node or tag itself.
+	activeConfigScopeOwn = "own"
+
+	// activeConfigScopeMerged selects the configuration merged with the configuration of the parent nodes.
+	activeConfigScopeMerged = "merged"
+)
+
+// NewActiveConfigDataSource is a helper function to simplify the provider implementation.
+func NewActiveConfigDataSource() datasource.DataSource {
+	return &activeConfigDataSource{
+		dataSourceBase: newDataSourceBase("active_config"),
+	}
+}
+
+type activeConfigDataSource struct {
+	dataSourceBase
+}
+
+type activeConfigDataSourceModel struct {
+	Node        types.String                    `tfsdk:"node"`
+	Tag         types.String                    `tfsdk:"tag"`
+	Scope       types.String                    `tfsdk:"scope"`
+	Bundles     []string                        `tfsdk:"bundles"`
+	BundlesJSON map[string]jsontypes.Normalized `tfsdk:"bundles_json"`
+	BundleData  types.Object                    `tfsdk:"bundle_data"`
+	Timeouts    timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+// getConfigurationResource returns the configuration resource, which is embedded in all configuration resources.
+func (r *configurationResource) getConfigurationResource() *configurationResource {
+	return r
+}
+
+// bundleDataModel is implemented by the models of the configuration resources that can be read from bundle data.
+type bundleDataModel interface {
+	setEntityID(entityType config.EntityType, entityID string)
+	setStateOnlyAttributes(state configurationResourceModel)
+	setEffective(effective types.Object)
+	fromBundleData(bundleData config.BundleData) error
+}
+
+// typedBundle is a configuration bundle with a dedicated resource, whose data is returned as typed attributes.
+type typedBundle struct {
+	resource *configurationResource
+	schema   resourceschema.Schema
+}
+
+// typedBundles returns the bundles of the configuration resources of the provider, by bundle name.
+// The attributes of each bundle are the attributes of the effective configuration of its resource.
+func typedBundles(ctx context.Context) map[string]typedBundle {
+	bundles := make(map[string]typedBundle)
+
+	for _, newResource := range (&QbeeProvider{}).Resources(ctx) {
+		r := newResource()
+
+		// The configuration bundle resource manages any bundle as JSON, which is covered by bundles_json
+		if _, ok := r.(*configurationBundleResource); ok {
+			continue
+		}
+
+		configurationResource, ok := r.(interface{ getConfigurationResource() *configurationResource })
+		if !ok {
+			continue
+		}
+
+		base := configurationResource.getConfigurationResource()
+		if _, ok := base.modelFactory().(bundleDataModel); !ok {
+			continue
+		}
+
+		var resp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+		bundles[base.name] = typedBundle{resource: base, schema: resp.Schema}
+	}
+
+	return bundles
+}
+
+// Schema defines the schema for the data source.
+func (d *activeConfigDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	bundleAttributes := make(map[string]schema.Attribute)
+	for name, bundle := range typedBundles(ctx) {
+		effective := dataSourceAttribute(bundle.schema.Attributes["effective"]).(schema.SingleNestedAttribute)
+		effective.Description = fmt.Sprintf("The data of the %s bundle, with the attributes of the qbee_%s resource. "+
+			"Null if the bundle is not configured.", name, name)
+
+		bundleAttributes[name] = effective
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Active config reads the active configuration of a node or tag, without managing it.",
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Optional:    true,
+				Description: "The node to read the configuration of. Either tag or node is required.",
+			},
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "The tag to read the configuration of. Either tag or node is required.",
+			},
+			"scope": schema.StringAttribute{
+				Optional: true,
+				Description: "Either own, to read the configuration set on the node or tag itself, or merged, to read " +
+					"the configuration merged with the configuration inherited from its parent nodes. Defaults to merged.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(activeConfigScopeOwn, activeConfigScopeMerged),
+				},
+			},
+			"bundles": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the configured bundles.",
+			},
+			"bundles_json": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: jsontypes.NormalizedType{},
+				Description: "The data of the configured bundles as JSON, by bundle name, as returned by the API. " +
+					"This includes bundles without a dedicated resource and keys unknown to the provider. Secrets, like passwords and registry credentials, are left out.",
+			},
+			"bundle_data": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The data of the configured bundles as typed attributes, by bundle name.",
+				Attributes:  bundleAttributes,
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+func (d *activeConfigDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("tag"),
+			path.MatchRoot("node"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *activeConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state activeConfigDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, d.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	entityType, entityID := config.EntityTypeNode, state.Node.ValueString()
+	if !state.Tag.IsNull() {
+		entityType, entityID = config.EntityTypeTag, state.Tag.ValueString()
+	}
+
+	scope := config.EntityConfigScopeAll
+	if state.Scope.ValueString() == activeConfigScopeOwn {
+		scope = config.EntityConfigScopeOwn
+	}
+
+	rawConfig, err := d.client.getRawActiveConfig(ctx, entityType, entityID, scope)
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadingActiveConfig,
+			fmt.Sprintf("error reading the active configuration of %s %s: %v", entityType, entityID, err))
+		return
+	}
+
+	state.Bundles = make([]string, 0, len(rawConfig.Bundles))
+	for _, bundle := range rawConfig.Bundles {
+		state.Bundles = append(state.Bundles, string(bundle))
+	}
+
+	state.BundlesJSON, err = bundlesJSON(rawConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadingActiveConfig, "error converting the bundle data to JSON: "+err.Error())
+		return
+	}
+
+	activeConfig, err := rawConfig.typed()
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadingActiveConfig, "error decoding the bundle data: "+err.Error())
+		return
+	}
+
+	state.BundleData, diags = bundleDataValue(ctx, resp.State, activeConfig)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// bundlesJSON returns the data of the configured bundles of the active configuration as JSON, by bundle name.
+// The data is kept as returned by the API, including bundles and keys unknown to the API client. The values of
+// sensitive keys are left out, see stripSecrets.
+func bundlesJSON(activeConfig *rawActiveConfig) (map[string]jsontypes.Normalized, error) {
+	result := make(map[string]jsontypes.Normalized)
+	for _, bundle := range activeConfig.Bundles {
+		bundleJSON, found := activeConfig.BundleData[string(bundle)]
+		if !found || string(bundleJSON) == "null" {
+			continue
+		}
+
+		content, err := decodeJSONObject(string(bundleJSON))
+		if err != nil {
+			return nil, err
+		}
+
+		contentJSON, err := encodeJSON(stripSecrets(content))
+		if err != nil {
+			return nil, err
+		}
+
+		result[string(bundle)] = jsontypes.NewNormalizedValue(contentJSON)
+	}
+
+	return result, nil
+}
+
+// stripSecrets removes the keys whose values are masked in the HTTP request logs from the decoded JSON
+// value, like the proxy password and the passwords of registry credentials.
+func stripSecrets(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, nested := range typed {
+			if isSensitiveKey(key) {
+				delete(typed, key)
+				continue
+			}
+
+			typed[key] = stripSecrets(nested)
+		}
+	case []any:
+		for i, nested := range typed {
+			typed[i] = stripSecrets(nested)
+		}
+	}
+
+	return value
+}
+
+// bundleDataValue returns the value of the bundle_data attribute from the active configuration.
+// The state is only used for its schema.
+func bundleDataValue(ctx context.Context, state tfsdk.State, activeConfig *config.Config) (types.Object, diag.Diagnostics) {
+	attributeType, diags := state.Schema.TypeAtPath(ctx, path.Root("bundle_data"))
+	if diags.HasError() {
+		return types.ObjectNull(nil), diags
+	}
+
+	bundleDataType := attributeType.(types.ObjectType)
+	bundles := typedBundles(ctx)
+
+	values := make(map[string]attr.Value, len(bundleDataType.AttrTypes))
+	for name, valueType := range bundleDataType.AttrTypes {
+		values[name] = types.ObjectNull(valueType.(types.ObjectType).AttrTypes)
+
+		bundle, found := bundles[name]
+		if !found || !slices.Contains(activeConfig.Bundles, config.Bundle(name)) {
+			continue
+		}
+
+		value, valueDiags := bundle.value(ctx, activeConfig)
+		if diags.Append(valueDiags...); valueDiags.HasError() {
+			return types.ObjectNull(bundleDataType.AttrTypes), diags
+		}
+
+		values[name] = value
+	}
+
+	value, valueDiags := types.ObjectValue(bundleDataType.AttrTypes, values)
+	diags.Append(valueDiags...)
+
+	return value, diags
+}
+
+// value returns the data of the bundle in the active configuration, converted by the model of its resource
+// to the value of the effective attribute of the resource.
+func (b typedBundle) value(ctx context.Context, activeConfig *config.Config) (types.Object, diag.Diagnostics) {
+	state := tfsdk.State{Schema: b.schema}
+
+	effectiveType, diags := effectiveObjectType(ctx, state)
+	if diags.HasError() {
+		return types.ObjectNull(nil), diags
+	}
+
+	null := types.ObjectNull(effectiveType.AttrTypes)
+
+	timeoutsType, timeoutsDiags := b.schema.TypeAtPath(ctx, path.Root("timeouts"))
+	if diags.Append(timeoutsDiags...); diags.HasError() {
+		return null, diags
+	}
+
+	model := b.resource.modelFactory().(bundleDataModel)
+	model.setEntityID(activeConfig.Type, activeConfig.EntityID)
+	model.setStateOnlyAttributes(configurationResourceModel{
+		Timeouts: resourcetimeouts.Value{Object: types.ObjectNull(timeoutsType.(resourcetimeouts.Type).AttrTypes)},
+	})
+	model.setEffective(null)
+
+	if err := model.fromBundleData(activeConfig.BundleData); err != nil {
+		diags.AddError(errorReadingActiveConfig,
+			fmt.Sprintf("error parsing the %s bundle data: %v", b.resource.name, err))
+		return null, diags
+	}
+
+	return effectiveValue(ctx, state, model)
+}
+
+// dataSourceAttributes returns data source copies of the given computed resource attributes, see dataSourceAttribute.
+func dataSourceAttributes(attributes map[string]resourceschema.Attribute) map[string]schema.Attribute {
+	converted := make(map[string]schema.Attribute, len(attributes))
+
+	for name, attribute := range attributes {
+		converted[name] = dataSourceAttribute(attribute)
+	}
+
+	return converted
+}
+
+// dataSourceAttribute returns a computed data source copy of the given resource attribute, like computedAttribute.
+func dataSourceAttribute(attribute resourceschema.Attribute) schema.Attribute {
+	switch attribute := attribute.(type) {
+	case resourceschema.StringAttribute:
+		return schema.StringAttribute{
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case resourceschema.BoolAttribute:
+		return schema.BoolAttribute{
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case resourceschema.Int64Attribute:
+		return schema.Int64Attribute{
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case resourceschema.Float64Attribute:
+		return schema.Float64Attribute{
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case resourceschema.ListAttribute:
+		return schema.ListAttribute{
+			ElementType: attribute.ElementType,
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case resourceschema.ListNestedAttribute:
+		return schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: dataSourceAttributes(attribute.NestedObject.Attributes),
+			},
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case resourceschema.SingleNestedAttribute:
+		return schema.SingleNestedAttribute{
+			Attributes:  dataSourceAttributes(attribute.Attributes),
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	default:
+		panic(fmt.Sprintf("unsupported attribute type %T for a data source", attribute))
+	}
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.qbee.io/client/config"
+)
+
+func TestAccActiveConfigDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "qbee_ntp" "test" {
+  tag = "terraform:acctest:active_config"
+  extend = true
+  servers = ["0.pool.ntp.org"]
+  time_zone = "Europe/Oslo"
+}
+
+data "qbee_active_config" "test" {
+  tag = qbee_ntp.test.tag
+  scope = "own"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.qbee_active_config.test", "bundles.#", "1"),
+					resource.TestCheckResourceAttr("data.qbee_active_config.test", "bundles.0", "ntp"),
+					resource.TestCheckResourceAttrSet("data.qbee_active_config.test", "bundles_json.ntp"),
+					resource.TestCheckResourceAttr("data.qbee_active_config.test", "bundle_data.ntp.servers.0", "0.pool.ntp.org"),
+					resource.TestCheckResourceAttr("data.qbee_active_config.test", "bundle_data.ntp.time_zone", "Europe/Oslo"),
+					resource.TestCheckNoResourceAttr("data.qbee_active_config.test", "bundle_data.firewall"),
+				),
+			},
+		},
+	})
+}
+
+func testActiveConfig() *config.Config {
+	return &config.Config{
+		Type:     config.EntityTypeTag,
+		EntityID: "test",
+		Bundles:  []config.Bundle{config.NTPBundle},
+		BundleData: config.BundleData{
+			NTP: &config.NTP{
+				Metadata: config.Metadata{Enabled: true, Extend: true},
+				Servers:  []config.NTPServer{{Server: "0.pool.ntp.org"}},
+				TimeZone: "Europe/Oslo",
+			},
+		},
+	}
+}
+
+func TestActiveConfigBundleData(t *testing.T) {
+	ctx := context.Background()
+
+	var resp datasource.SchemaResponse
+	NewActiveConfigDataSource().Schema(ctx, datasource.SchemaRequest{}, &resp)
+
+	bundles := typedBundles(ctx)
+	for _, name := range []string{"ntp", "firewall", "parameters", "proxy"} {
+		if _, found := bundles[name]; !found {
+			t.Errorf("typedBundles() has no %s bundle", name)
+		}
+	}
+
+	value, diags := bundleDataValue(ctx, tfsdk.State{Schema: resp.Schema}, testActiveConfig())
+	if diags.HasError() {
+		t.Fatalf("bundleDataValue() diagnostics = %v", diags)
+	}
+
+	if firewall := value.Attributes()["firewall"]; !firewall.IsNull() {
+		t.Errorf("bundle_data.firewall = %v, want null", firewall)
+	}
+
+	ntp, ok := value.Attributes()["ntp"].(types.Object)
+	if !ok || ntp.IsNull() {
+		t.Fatalf("bundle_data.ntp = %v, want an object", value.Attributes()["ntp"])
+	}
+
+	if timeZone := ntp.Attributes()["time_zone"]; !timeZone.Equal(types.StringValue("Europe/Oslo")) {
+		t.Errorf("bundle_data.ntp.time_zone = %v, want Europe/Oslo", timeZone)
+	}
+}
+
+func TestBundlesJSON(t *testing.T) {
+	activeConfig := &rawActiveConfig{
+		Bundles: []config.Bundle{config.NTPBundle, "custom_bundle"},
+		BundleData: map[string]json.RawMessage{
+			"ntp":           json.RawMessage(`{"timezone":"Europe/Oslo","enabled":true,"new_key":"kept"}`),
+			"custom_bundle": json.RawMessage(`{"enabled":true}`),
+			"firewall":      json.RawMessage(`{"enabled":true}`),
+		},
+	}
+
+	bundles, err := bundlesJSON(activeConfig)
+	if err != nil {
+		t.Fatalf("bundlesJSON() error = %v", err)
+	}
+
+	if len(bundles) != 2 {
+		t.Fatalf("bundlesJSON() = %v, want only the configured bundles", bundles)
+	}
+
+	want := `{"enabled":true,"new_key":"kept","timezone":"Europe/Oslo"}`
+	if got := bundles["ntp"].ValueString(); got != want {
+		t.Errorf("bundlesJSON()[ntp] = %s, want %s", got, want)
+	}
+
+	if got := bundles["custom_bundle"].ValueString(); got != `{"enabled":true}` {
+		t.Errorf("bundlesJSON()[custom_bundle] = %s, want the unknown bundle", got)
+	}
+}
+
+func TestBundlesJSONStripsSecrets(t *testing.T) {
+	activeConfig := &rawActiveConfig{
+		Bundles: []config.Bundle{config.ProxyBundle, config.DockerComposeBundle},
+		BundleData: map[string]json.RawMessage{
+			"proxy": json.RawMessage(`{"server":"proxy.example.com","port":"3128","user":"qbee",` +
+				`"password":"proxy-password"}`),
+			"docker_compose": json.RawMessage(`{"registry_auths":[{"server":"registry.example.com",` +
+				`"username":"qbee","password":"registry-password"}]}`),
+		},
+	}
+
+	bundles, err := bundlesJSON(activeConfig)
+	if err != nil {
+		t.Fatalf("bundlesJSON() error = %v", err)
+	}
+
+	for name, bundle := range bundles {
+		if got := bundle.ValueString(); strings.Contains(got, "proxy-password") || strings.Contains(got, "registry-password") {
+			t.Errorf("bundlesJSON()[%s] = %s, want no passwords", name, got)
+		}
+	}
+
+	if got := bundles["proxy"].ValueString(); !strings.Contains(got, "proxy.example.com") {
+		t.Errorf("bundlesJSON()[proxy] = %s, want the host", got)
+	}
+
+	if got := bundles["docker_compose"].ValueString(); !strings.Contains(got, "registry.example.com") {
+		t.Errorf("bundlesJSON()[docker_compose] = %s, want the registry server", got)
+	}
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.qbee.io/client"
+)
+
+const (
+	loginPath           = "/api/v2/login"
+	challengeGetPath    = "/api/v2/challenge-get"
+	challengeVerifyPath = "/api/v2/challenge-verify"
+
+	// totpChallengeProvider selects the authenticator app (TOTP) provider for a two-factor challenge.
+	totpChallengeProvider = "google"
+)
+
+type loginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// loginResponse contains either the session token, or a challenge when two-factor authentication is enforced.
+type loginResponse struct {
+	Token     string `json:"token"`
+	Challenge string `json:"challenge"`
+}
+
+type challengeGetRequest struct {
+	Challenge      string `json:"challenge"`
+	PreferProvider string `json:"preferProvider"`
+}
+
+type challengeVerifyRequest struct {
+	Challenge string `json:"challenge"`
+	Code      string `json:"code"`
+}
+
+type challengeVerifyResponse struct {
+	Token string `json:"token"`
+}
+
+// credentials are the login credentials of a Qbee user.
+type credentials struct {
+	username string
+	password string
+
+	// totpSecret is the base32 encoded authenticator app secret used to complete two-factor challenges.
+	totpSecret string
+}
+
+// login authenticates with the given credentials and starts a new session.
+// The credentials are kept, so the session can transparently be renewed when it expires.
+func (cli *Client) login(ctx context.Context, creds credentials) error {
+	token, err := cli.newSessionToken(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	cli.session.start(token, func(ctx context.Context) (string, error) {
+		return cli.newSessionToken(ctx, creds)
+	})
+
+	return nil
+}
+
+// setAPIToken authenticates all requests with a pre-issued API token.
+// Such sessions can't be renewed, as there are no credentials to log in with.
+func (cli *Client) setAPIToken(token string) {
+	cli.session.start(token, nil)
+}
+
+// newSessionToken logs in with the given credentials and returns the new session token.
+// It uses a separate API client, so that logging in never interferes with requests running concurrently.
+// If the account enforces two-factor authentication, the challenge is completed using a one-time code
+// generated from the TOTP secret.
+func (cli *Client) newSessionToken(ctx context.Context, creds credentials) (string, error) {
+	loginClient := client.New().WithHTTPClient(cli.httpClient)
+	if cli.baseURL != "" {
+		loginClient = loginClient.WithBaseURL(cli.baseURL)
+	}
+
+	login := new(loginResponse)
+	request := loginRequest{Email: creds.username, Password: creds.password}
+
+	if err := loginClient.Call(ctx, http.MethodPost, loginPath, request, login); err != nil {
+		return "", fmt.Errorf("error logging in: %w", err)
+	}
+
+	// The account does not have two-factor authentication enabled
+	if login.Token != "" {
+		return login.Token, nil
+	}
+
+	if login.Challenge == "" {
+		return "", fmt.Errorf("error logging in: response contains neither a token nor a two-factor challenge")
+	}
+
+	if creds.totpSecret == "" {
+		return "", fmt.Errorf("error logging in: the account requires two-factor authentication, but no TOTP secret is configured")
+	}
+
+	getRequest := challengeGetRequest{
+		Challenge:      login.Challenge,
+		PreferProvider: totpChallengeProvider,
+	}
+
+	if err := loginClient.Call(ctx, http.MethodPost, challengeGetPath, getRequest, nil); err != nil {
+		return "", fmt.Errorf("error requesting two-factor challenge: %w", err)
+	}
+
+	code, err := generateTOTP(creds.totpSecret, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	verifyRequest := challengeVerifyRequest{
+		Challenge: login.Challenge,
+		Code:      code,
+	}
+
+	verified := new(challengeVerifyResponse)
+	if err = loginClient.Call(ctx, http.MethodPost, challengeVerifyPath, verifyRequest, verified); err != nil {
+		return "", fmt.Errorf("error verifying two-factor challenge: %w", err)
+	}
+
+	if verified.Token == "" {
+		return "", fmt.Errorf("error verifying two-factor challenge: response does not contain a token")
+	}
+
+	return verified.Token, nil
+}
+
+// sessionTransport is an http.RoundTripper that authenticates requests with the token of the current session.
+//
+// When the API rejects a request with 401 Unauthorized because the session expired or became invalid, it logs in
+// again and replays the request once with the new token. Concurrent requests failing with the same expired token
+// trigger only a single login.
+type sessionTransport struct {
+	next http.RoundTripper
+
+	mu    sync.RWMutex
+	token string
+
+	// renew logs in again and returns a new session token. It is nil if the session can't be renewed.
+	renew func(ctx context.Context) (string, error)
+}
+
+// start sets the token of a new session, and the function used to renew it.
+func (t *sessionTransport) start(token string, renew func(ctx context.Context) (string, error)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.token = token
+	t.renew = renew
+}
+
+// currentToken returns the token of the current session.
+func (t *sessionTransport) currentToken() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.token
+}
+
+// RoundTrip executes the request with the current session token, renewing the session if it expired.
+func (t *sessionTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	// Login requests are not authenticated and must never trigger a login themselves
+	if isLoginRequest(request) {
+		return t.next.RoundTrip(request)
+	}
+
+	token := t.currentToken()
+
+	response, err := t.next.RoundTrip(withBearerToken(request, token))
+	if err != nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+
+	// A request with a body that can't be replayed, like a streamed file upload, is returned as is
+	if request.Body != nil && request.GetBody == nil {
+		return response, nil
+	}
+
+	newToken, err := t.renewSession(request.Context(), token)
+	if err != nil {
+		tflog.Warn(request.Context(), "Unable to renew the Qbee API session", map[string]any{"error": err.Error()})
+		return response, nil
+	}
+
+	if newToken == "" {
+		return response, nil
+	}
+
+	_ = response.Body.Close()
+
+	replay := withBearerToken(request, newToken)
+	if request.Body != nil {
+		if replay.Body, err = request.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.next.RoundTrip(replay)
+}
+
+// renewSession returns a valid session token to replace the given expired token.
+// If another request renewed the session in the meantime, its token is returned without logging in again.
+// An empty token is returned if the session can't be renewed.
+func (t *sessionTransport) renewSession(ctx context.Context, expiredToken string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != expiredToken {
+		return t.token, nil
+	}
+
+	if t.renew == nil {
+		return "", nil
+	}
+
+	tflog.Info(ctx, "Qbee API session expired, logging in again")
+
+	token, err := t.renew(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	t.token = token
+
+	return token, nil
+}
+
+// isLoginRequest returns true for requests to the unauthenticated login endpoints.
+func isLoginRequest(request *http.Request) bool {
+	for _, loginEndpoint := range []string{loginPath, challengeGetPath, challengeVerifyPath} {
+		if strings.HasSuffix(request.URL.Path, loginEndpoint) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withBearerToken returns a copy of the request that is authenticated with the given token.
+func withBearerToken(request *http.Request, token string) *http.Request {
+	request = request.Clone(request.Context())
+
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return request
+}
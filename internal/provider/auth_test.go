@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// newSessionServer returns a test server that only accepts requests authenticated with the given token.
+func newSessionServer(t *testing.T, validToken string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSessionTransportRenewsExpiredSession(t *testing.T) {
+	server := newSessionServer(t, "renewed")
+
+	logins := new(atomic.Int32)
+	transport := &sessionTransport{next: http.DefaultTransport}
+	transport.start("expired", func(ctx context.Context) (string, error) {
+		logins.Add(1)
+		return "renewed", nil
+	})
+
+	httpClient := &http.Client{Transport: transport}
+
+	// Run concurrent requests with the same expired token, which must all succeed after a single login
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			response, err := httpClient.Post(server.URL+"/api/v2/configuration", "text/plain", bytes.NewReader([]byte("payload")))
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			defer response.Body.Close()
+
+			body, _ := io.ReadAll(response.Body)
+			if response.StatusCode != http.StatusOK || string(body) != "payload" {
+				t.Errorf("got status %d with body %q, want replayed request", response.StatusCode, body)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := logins.Load(); got != 1 {
+		t.Errorf("got %d logins, want 1", got)
+	}
+
+	if got := transport.currentToken(); got != "renewed" {
+		t.Errorf("got token %q, want renewed", got)
+	}
+}
+
+func TestSessionTransportWithoutRenewal(t *testing.T) {
+	server := newSessionServer(t, "valid")
+
+	transport := &sessionTransport{next: http.DefaultTransport}
+	transport.start("api-token", nil)
+
+	response, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = response.Body.Close()
+
+	if response.StatusCode != http.StatusUnauthorized {
+		t.Errorf("got status %d, want %d", response.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestSessionTransportSkipsLoginRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("login request must not be authenticated")
+		}
+
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	transport := &sessionTransport{next: http.DefaultTransport}
+	transport.start("token", func(ctx context.Context) (string, error) {
+		t.Error("login request must not trigger a login")
+		return "", nil
+	})
+
+	response, err := (&http.Client{Transport: transport}).Post(server.URL+loginPath, "application/json", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = response.Body.Close()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,13 +37,14 @@ type bootstrapKeyResource struct {
 }
 
 type bootstrapKeyResourceModel struct {
-	Id         types.String `tfsdk:"id"`
-	GroupId    types.String `tfsdk:"group_id"`
-	AutoAccept types.Bool   `tfsdk:"auto_accept"`
+	Id         types.String   `tfsdk:"id"`
+	GroupId    types.String   `tfsdk:"group_id"`
+	AutoAccept types.Bool     `tfsdk:"auto_accept"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Schema defines the schema for the resource.
-func (r *bootstrapKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *bootstrapKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -59,12 +61,22 @@ func (r *bootstrapKeyResource) Schema(_ context.Context, _ resource.SchemaReques
 				Required:    true,
 				Description: "Indicates whether the bootstrap key is auto accepted.",
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *bootstrapKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.checkReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the plan
 	var plan bootstrapKeyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -73,6 +85,15 @@ func (r *bootstrapKeyResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.defaultTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating bootstrap key associated with group ID: %s", plan.GroupId.ValueString()))
 
 	bootstrapKey, err := r.client.NewBootstrapKey(ctx)
@@ -117,6 +138,10 @@ func (r *bootstrapKeyResource) Create(ctx context.Context, req resource.CreateRe
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *bootstrapKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the plan
 	var plan bootstrapKeyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -130,6 +155,15 @@ func (r *bootstrapKeyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.defaultTimeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating bootstrap key associated with group ID: %s", plan.GroupId.ValueString()))
 	payload := client.BootstrapKey{
 		ID:         state.Id.ValueString(),
@@ -168,6 +202,15 @@ func (r *bootstrapKeyResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.defaultTimeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Read the real status
 	bootstrapKey, err := r.client.GetBootstrapKey(ctx, state.Id.ValueString())
 	if err != nil {
@@ -196,6 +239,10 @@ func (r *bootstrapKeyResource) Read(ctx context.Context, req resource.ReadReques
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *bootstrapKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the state
 	var state bootstrapKeyResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -204,6 +251,15 @@ func (r *bootstrapKeyResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.defaultTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the resource
 	tflog.Info(ctx, fmt.Sprintf("Deleting bootstrap key associated with group ID: %s", state.GroupId.ValueString()))
 
@@ -2,54 +2,129 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
-	"sync"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.qbee.io/client"
 	"go.qbee.io/client/config"
 )
 
+// errReadOnly is returned by the mutating methods of Client when the provider is configured in read-only mode.
+var errReadOnly = errors.New("the provider is configured in read-only mode, which does not allow changes")
+
 // Client is a wrapper around the Qbee API client that can be used as the provider data and resource data.
 // It allows to easily access the Qbee API client from the resources and data sources.
 type Client struct {
-	sync.Mutex
 	*client.Client
+
+	// httpClient is the HTTP client used to log in, which does not authenticate requests with the session token.
+	httpClient *http.Client
+
+	// baseURL is the base URL of the Qbee API, or empty to use the default.
+	baseURL string
+
+	// session authenticates the requests of the Qbee API client and renews the session when it expires.
+	session *sessionTransport
+
+	// defaultTimeouts are the operation timeouts of resources that don't configure their own.
+	defaultTimeouts operationTimeouts
+
+	// readOnly refuses all changes through the client, so that it can only be used to read data.
+	readOnly bool
+
+	// limiter serialises conflicting changes and bounds the number of concurrent changes.
+	limiter *operationLimiter
+
+	// batcher combines the configuration changes of resources into a single commit, or is nil if disabled.
+	batcher *commitBatcher
 }
 
-// NewClient creates a new Client instance with the given Qbee API client.
-func NewClient() *Client {
+// NewClient creates a new Client instance with a Qbee API client that uses the given HTTP client and base URL.
+// The client must be authenticated with login or setAPIToken before use.
+func NewClient(httpClient *http.Client, baseURL string) *Client {
+	session := &sessionTransport{next: httpClient.Transport}
+	if session.next == nil {
+		session.next = http.DefaultTransport
+	}
+
+	sessionClient := *httpClient
+	sessionClient.Transport = session
+
+	qbeeClient := client.New().WithHTTPClient(&sessionClient)
+	if baseURL != "" {
+		qbeeClient = qbeeClient.WithBaseURL(baseURL)
+	}
+
 	return &Client{
-		Client: client.New(),
+		Client:     qbeeClient,
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		session:    session,
+		limiter:    newOperationLimiter(defaultMaxConcurrency),
 	}
 }
 
 // UploadFile uploads a file to the Qbee API using the provided path, name, and reader.
-// It locks the client to prevent concurrent uploads and ensures that only one upload operation is performed at a time.
+// Changes to the same file manager path are serialised, changes to other paths run concurrently.
+// It fails with errReadOnly if the client is read-only.
 func (cli *Client) UploadFile(ctx context.Context, path, name string, reader io.Reader) error {
-	cli.Lock()
-	defer cli.Unlock()
+	if cli.readOnly {
+		return errReadOnly
+	}
 
-	return cli.Client.UploadFile(ctx, path, name, reader)
+	return cli.limiter.run(ctx, []string{fileLockKey(path + "/" + name)}, func() error {
+		return cli.Client.UploadFile(ctx, path, name, reader)
+	})
+}
+
+// CreateDirectory creates a directory in the Qbee file manager with the given parent path and name.
+// Changes to the same file manager path are serialised, changes to other paths run concurrently.
+// It fails with errReadOnly if the client is read-only.
+func (cli *Client) CreateDirectory(ctx context.Context, path, name string) error {
+	if cli.readOnly {
+		return errReadOnly
+	}
+
+	return cli.limiter.run(ctx, []string{fileLockKey(path + "/" + name)}, func() error {
+		return cli.Client.CreateDirectory(ctx, path, name)
+	})
 }
 
 // DeleteFile deletes a file from the Qbee API using the provided name.
-// It locks the client to prevent concurrent deletions and ensures that only one delete operation is performed at a time.
+// Changes to the same file manager path are serialised, changes to other paths run concurrently.
+// Deleting a directory waits for the changes to the paths inside it, and the other way round.
+// It fails with errReadOnly if the client is read-only.
 func (cli *Client) DeleteFile(ctx context.Context, name string) error {
-	cli.Lock()
-	defer cli.Unlock()
+	if cli.readOnly {
+		return errReadOnly
+	}
 
-	return cli.Client.DeleteFile(ctx, name)
+	return cli.limiter.run(ctx, []string{fileLockKey(name)}, func() error {
+		return cli.Client.DeleteFile(ctx, name)
+	})
 }
 
 // CommitConfiguration commits a configuration change to the Qbee API with the given message and change request.
-// It locks the client to prevent concurrent commits and ensures that only one commit operation is performed at a time.
+// Commits are made one at a time, as a commit takes all uncommitted changes of the user, including changes
+// staged for other nodes and tags.
+// It fails with errReadOnly if the client is read-only.
 func (cli *Client) CommitConfiguration(ctx context.Context, message string, changes ...client.ChangeRequest) (*client.Commit, error) {
-	cli.Lock()
-	defer cli.Unlock()
+	if cli.readOnly {
+		return nil, errReadOnly
+	}
+
+	var commit *client.Commit
+	err := cli.limiter.run(ctx, []string{commitLockKey}, func() error {
+		var err error
+		commit, err = cli.Client.CommitConfiguration(ctx, message, changes...)
+		return err
+	})
 
-	return cli.Client.CommitConfiguration(ctx, message, changes...)
+	return commit, err
 }
 
 // resourceModelManager defines common methods for managing resource models.
@@ -60,6 +135,16 @@ type resourceModelManager interface {
 	// setEntityID sets the entity ID (node or tag) on the model based on the provided entity type and ID.
 	setEntityID(entityType config.EntityType, entityID string)
 
+	// setStateOnlyAttributes copies the attributes that are not part of the bundle data, like the timeouts
+	// and the last commit, from the given model.
+	setStateOnlyAttributes(state configurationResourceModel)
+
+	// setLastCommit sets the attributes describing the last commit of the resource.
+	setLastCommit(commit *client.Commit)
+
+	// setEffective sets the effective configuration, merged with the configuration of the parent nodes.
+	setEffective(effective types.Object)
+
 	// getConfigBundle returns the configuration bundle associated with the resource model.
 	getConfigBundle() config.Bundle
 
@@ -72,6 +157,7 @@ type resourceModelManager interface {
 
 // commitConfiguration commits a configuration change for the given resource.
 // If reset is true, it will commit a reset operation, otherwise it will commit a set operation.
+// In batch mode, the change is committed together with the changes of other resources.
 func (cli *Client) commitConfiguration(ctx context.Context, model resourceModelManager, reset bool) (*client.Commit, error) {
 	baseModel := model.getBaseResourceModel()
 
@@ -87,12 +173,18 @@ func (cli *Client) commitConfiguration(ctx context.Context, model resourceModelM
 
 	tflog.Info(ctx, message)
 
+	// Deletions always use the default message, as the configured message describes the last change
+	message = "terraform: " + message
+	if !reset && baseModel.CommitMessage.ValueString() != "" {
+		message = baseModel.CommitMessage.ValueString()
+	}
+
 	metadata := config.Metadata{Version: "v1"}
 
 	if reset {
 		metadata.Reset = true
 	} else {
-		metadata.Enabled = true
+		metadata.Enabled = baseModel.Enabled.ValueBool()
 		metadata.Extend = baseModel.Extend.ValueBool()
 	}
 
@@ -112,5 +204,9 @@ func (cli *Client) commitConfiguration(ctx context.Context, model resourceModelM
 		return nil, fmt.Errorf("unsupported entity type: %s", entityType)
 	}
 
-	return cli.CommitConfiguration(ctx, "terraform: "+message, changeRequest)
+	if cli.batcher != nil {
+		return cli.batcher.submit(ctx, message, changeRequest)
+	}
+
+	return cli.CommitConfiguration(ctx, message, changeRequest)
 }
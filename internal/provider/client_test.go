@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.qbee.io/client"
+)
+
+func TestClientReadOnly(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	qbeeClient := NewClient(server.Client(), server.URL)
+	qbeeClient.readOnly = true
+
+	ctx := context.Background()
+
+	if err := qbeeClient.UploadFile(ctx, "/", "file.txt", strings.NewReader("data")); !errors.Is(err, errReadOnly) {
+		t.Errorf("UploadFile: got error %v, want %v", err, errReadOnly)
+	}
+
+	if err := qbeeClient.DeleteFile(ctx, "/file.txt"); !errors.Is(err, errReadOnly) {
+		t.Errorf("DeleteFile: got error %v, want %v", err, errReadOnly)
+	}
+
+	if _, err := qbeeClient.CommitConfiguration(ctx, "message", client.ChangeRequest{}); !errors.Is(err, errReadOnly) {
+		t.Errorf("CommitConfiguration: got error %v, want %v", err, errReadOnly)
+	}
+
+	if err := qbeeClient.setDeviceAttributes(ctx, "node", deviceAttributes{}); !errors.Is(err, errReadOnly) {
+		t.Errorf("setDeviceAttributes: got error %v, want %v", err, errReadOnly)
+	}
+
+	if err := qbeeClient.approveDevice(ctx, "node"); !errors.Is(err, errReadOnly) {
+		t.Errorf("approveDevice: got error %v, want %v", err, errReadOnly)
+	}
+
+	if err := qbeeClient.rejectDevice(ctx, "node"); !errors.Is(err, errReadOnly) {
+		t.Errorf("rejectDevice: got error %v, want %v", err, errReadOnly)
+	}
+
+	if err := qbeeClient.removeDevice(ctx, "node"); !errors.Is(err, errReadOnly) {
+		t.Errorf("removeDevice: got error %v, want %v", err, errReadOnly)
+	}
+
+	if n := requests.Load(); n != 0 {
+		t.Errorf("got %d requests to the API, want none", n)
+	}
+
+	base := newResourceBase("firewall")
+	base.client = qbeeClient
+
+	var diags diag.Diagnostics
+	if !base.checkReadOnly("create", &diags) || !diags.HasError() {
+		t.Error("expected checkReadOnly to refuse the change")
+	}
+
+	qbeeClient.readOnly = false
+	diags = nil
+	if base.checkReadOnly("create", &diags) || diags.HasError() {
+		t.Error("expected checkReadOnly to allow the change")
+	}
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.qbee.io/client"
+)
+
+// defaultBatchWindow is the time a batch waits for more changes before it is committed.
+const defaultBatchWindow = 2 * time.Second
+
+// commitFunc commits configuration changes to the Qbee API.
+type commitFunc func(ctx context.Context, message string, changes ...client.ChangeRequest) (*client.Commit, error)
+
+// commitBatcher queues configuration changes and commits them together as a single commit.
+//
+// Terraform has no notion of the end of an apply, so a batch is committed once no change was added to it
+// for the duration of the batch window. The resources applied concurrently by Terraform are therefore
+// committed together, while resources depending on each other still see the changes of their dependencies.
+type commitBatcher struct {
+	commit commitFunc
+	window time.Duration
+
+	// timeout bounds the commit of a batch with a change without a deadline.
+	timeout time.Duration
+
+	mu      sync.Mutex
+	pending *commitBatch
+}
+
+// commitBatch is a set of configuration changes that are committed together.
+type commitBatch struct {
+	changes []*batchedChange
+
+	// added is signalled when a change is added to the batch, to restart the batch window.
+	added chan struct{}
+
+	// deadline is the latest deadline of the changes, which bounds the commit of the batch.
+	deadline time.Time
+
+	// done is closed when the batch was committed, after which result and err are set.
+	done   chan struct{}
+	result *client.Commit
+	err    error
+}
+
+// batchedChange is a configuration change waiting in a batch, with the message of its commit.
+type batchedChange struct {
+	message string
+	change  client.ChangeRequest
+}
+
+// newCommitBatcher creates a commitBatcher that commits batches using the given function.
+// The timeout is used as the deadline of changes submitted without one.
+func newCommitBatcher(commit commitFunc, window, timeout time.Duration) *commitBatcher {
+	return &commitBatcher{
+		commit:  commit,
+		window:  window,
+		timeout: timeout,
+	}
+}
+
+// submit adds the change to the pending batch and waits until the batch is committed.
+// All changes of a batch share the resulting commit, or the error if the commit failed.
+// When the context is cancelled before the batch is committed, the change is taken out of the batch again.
+// When it is cancelled once the commit has started, the context error is returned, although the commit may
+// still be made. The commit of the batch is bounded by the latest deadline of its changes.
+func (b *commitBatcher) submit(ctx context.Context, message string, change client.ChangeRequest) (*client.Commit, error) {
+	b.mu.Lock()
+
+	batch := b.pending
+	if batch == nil {
+		batch = &commitBatch{
+			added: make(chan struct{}, 1),
+			done:  make(chan struct{}),
+		}
+		b.pending = batch
+
+		// The batch must be committed even if the context of the first change is cancelled
+		go b.run(context.WithoutCancel(ctx), batch)
+	}
+
+	entry := &batchedChange{message: message, change: change}
+	batch.changes = append(batch.changes, entry)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(b.timeout)
+	}
+
+	if deadline.After(batch.deadline) {
+		batch.deadline = deadline
+	}
+
+	select {
+	case batch.added <- struct{}{}:
+	default:
+	}
+
+	b.mu.Unlock()
+
+	tflog.Debug(ctx, fmt.Sprintf("Queued configuration change for batch commit: %s", message))
+
+	select {
+	case <-batch.done:
+		return batch.result, batch.err
+	case <-ctx.Done():
+	}
+
+	b.mu.Lock()
+
+	// The batch is pending until the commit starts, so the change can still be left out of it
+	if b.pending == batch {
+		batch.changes = slices.DeleteFunc(batch.changes, func(c *batchedChange) bool { return c == entry })
+	}
+
+	b.mu.Unlock()
+
+	return nil, fmt.Errorf("waiting for batch commit: %w", ctx.Err())
+}
+
+// run commits the batch once no change was added to it for the duration of the batch window.
+// The commit is cancelled at the deadline of the batch.
+func (b *commitBatcher) run(ctx context.Context, batch *commitBatch) {
+	timer := time.NewTimer(b.window)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-batch.added:
+			timer.Reset(b.window)
+			continue
+		case <-timer.C:
+		}
+
+		b.mu.Lock()
+
+		// A change may have been added after the timer fired
+		select {
+		case <-batch.added:
+			b.mu.Unlock()
+			timer.Reset(b.window)
+			continue
+		default:
+		}
+
+		b.pending = nil
+		b.mu.Unlock()
+
+		break
+	}
+
+	defer close(batch.done)
+
+	// No change is added to the batch anymore, so its deadline is final
+	ctx, cancel := context.WithDeadline(ctx, batch.deadline)
+	defer cancel()
+
+	// All changes may have been taken out of the batch by cancelled contexts
+	if len(batch.changes) == 0 {
+		return
+	}
+
+	messages := make([]string, len(batch.changes))
+	changes := make([]client.ChangeRequest, len(batch.changes))
+	for i, c := range batch.changes {
+		messages[i], changes[i] = c.message, c.change
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Committing batch of %d configuration changes", len(changes)))
+
+	batch.result, batch.err = b.commit(ctx, batchCommitMessage(messages), changes...)
+}
+
+// batchCommitMessage combines the messages of the changes of a batch into one commit message.
+func batchCommitMessage(messages []string) string {
+	if len(messages) == 1 {
+		return messages[0]
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "terraform: %d configuration changes\n", len(messages))
+
+	for _, message := range messages {
+		builder.WriteString("\n- " + strings.TrimPrefix(message, "terraform: "))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.qbee.io/client"
+)
+
+// recordingCommitter records the commits made by a commitBatcher.
+type recordingCommitter struct {
+	mu      sync.Mutex
+	commits [][]client.ChangeRequest
+	message string
+	err     error
+}
+
+func (c *recordingCommitter) commit(_ context.Context, message string, changes ...client.ChangeRequest) (*client.Commit, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.commits = append(c.commits, changes)
+	c.message = message
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &client.Commit{SHA: fmt.Sprintf("sha-%d", len(c.commits)), Message: message}, nil
+}
+
+func TestCommitBatcherCombinesConcurrentChanges(t *testing.T) {
+	committer := &recordingCommitter{}
+	batcher := newCommitBatcher(committer.commit, 50*time.Millisecond, time.Minute)
+
+	var wg sync.WaitGroup
+	shas := make([]string, 5)
+
+	for i := range shas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			commit, err := batcher.submit(t.Context(), fmt.Sprintf("change %d", i), client.ChangeRequest{Tag: fmt.Sprint(i)})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			shas[i] = commit.SHA
+		}()
+	}
+
+	wg.Wait()
+
+	if len(committer.commits) != 1 || len(committer.commits[0]) != len(shas) {
+		t.Fatalf("got commits %v, want a single commit with %d changes", committer.commits, len(shas))
+	}
+
+	for i, sha := range shas {
+		if sha != "sha-1" {
+			t.Errorf("change %d: got commit %q, want sha-1", i, sha)
+		}
+	}
+
+	if !strings.HasPrefix(committer.message, "terraform: 5 configuration changes\n") {
+		t.Errorf("unexpected commit message %q", committer.message)
+	}
+}
+
+func TestCommitBatcherSeparatesSequentialChanges(t *testing.T) {
+	committer := &recordingCommitter{}
+	batcher := newCommitBatcher(committer.commit, 10*time.Millisecond, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		if _, err := batcher.submit(t.Context(), "change", client.ChangeRequest{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(committer.commits) != 2 {
+		t.Fatalf("got %d commits, want 2", len(committer.commits))
+	}
+
+	if committer.message != "change" {
+		t.Errorf("got commit message %q, want %q", committer.message, "change")
+	}
+}
+
+func TestCommitBatcherReportsErrorToAllChanges(t *testing.T) {
+	commitErr := errors.New("commit rejected")
+	committer := &recordingCommitter{err: commitErr}
+	batcher := newCommitBatcher(committer.commit, 50*time.Millisecond, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := batcher.submit(t.Context(), "change", client.ChangeRequest{}); !errors.Is(err, commitErr) {
+				t.Errorf("got error %v, want %v", err, commitErr)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if len(committer.commits) != 1 {
+		t.Errorf("got %d commits, want 1", len(committer.commits))
+	}
+}
+
+func TestCommitBatcherContextCancelled(t *testing.T) {
+	committer := &recordingCommitter{}
+	batcher := newCommitBatcher(committer.commit, time.Hour, time.Minute)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := batcher.submit(ctx, "change", client.ChangeRequest{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestCommitBatcherContextCancelledRemovesChange(t *testing.T) {
+	committer := &recordingCommitter{}
+	batcher := newCommitBatcher(committer.commit, 100*time.Millisecond, time.Minute)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		if _, err := batcher.submit(t.Context(), "kept", client.ChangeRequest{BundleName: "kept"}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}()
+
+	if _, err := batcher.submit(ctx, "cancelled", client.ChangeRequest{BundleName: "cancelled"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	wg.Wait()
+
+	committer.mu.Lock()
+	defer committer.mu.Unlock()
+
+	if len(committer.commits) != 1 || len(committer.commits[0]) != 1 || committer.commits[0][0].BundleName != "kept" {
+		t.Errorf("got commits %v, want only the kept change", committer.commits)
+	}
+}
+
+func TestCommitBatcherContextCancelledDuringCommit(t *testing.T) {
+	started := make(chan struct{})
+
+	// The commit never returns, like a request to an API that hangs
+	batcher := newCommitBatcher(func(ctx context.Context, message string, changes ...client.ChangeRequest) (*client.Commit, error) {
+		close(started)
+		select {}
+	}, time.Millisecond, time.Minute)
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	if _, err := batcher.submit(ctx, "change", client.ChangeRequest{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestCommitBatcherCommitDeadline(t *testing.T) {
+	deadlines := make(chan time.Time, 2)
+
+	batcher := newCommitBatcher(func(ctx context.Context, message string, changes ...client.ChangeRequest) (*client.Commit, error) {
+		deadline, _ := ctx.Deadline()
+		deadlines <- deadline
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}, 50*time.Millisecond, time.Minute)
+
+	shortCtx, cancelShort := context.WithTimeout(t.Context(), 200*time.Millisecond)
+	defer cancelShort()
+
+	longCtx, cancelLong := context.WithTimeout(t.Context(), 400*time.Millisecond)
+	defer cancelLong()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		if _, err := batcher.submit(shortCtx, "short", client.ChangeRequest{}); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+		}
+	}()
+
+	if _, err := batcher.submit(longCtx, "long", client.ChangeRequest{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	wg.Wait()
+
+	// The commit is bounded by the latest deadline of the changes
+	if want, _ := longCtx.Deadline(); !(<-deadlines).Equal(want) {
+		t.Errorf("the commit has no deadline of %v", want)
+	}
+
+	// Without a deadline, the timeout of the batcher is used
+	batcher.timeout = 20 * time.Millisecond
+	if _, err := batcher.submit(context.Background(), "change", client.ChangeRequest{}); err == nil {
+		t.Error("got no error, want the commit to time out")
+	}
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"go.qbee.io/client"
+	"go.qbee.io/client/config"
+)
+
+const (
+	commitsPath = "/api/v2/commits"
+
+	// commitsPageSize is the number of commits requested per page when listing commits.
+	commitsPageSize = 200
+)
+
+type commitsResponse struct {
+	Items []client.Commit `json:"items"`
+}
+
+// listCommits calls visit with the committed commits, newest first, reading pages until a short page.
+// Listing stops early when visit returns false or an error, which is returned. Pages are read by offset, so commits made while listing
+// shift the following pages, which then start with commits of the previous page. Those are recognised by
+// their SHA and only visited once. As commits are never removed, no commits are skipped.
+func (cli *Client) listCommits(ctx context.Context, visit func(commit client.Commit) (bool, error)) error {
+	visited := make(map[string]bool)
+
+	for offset := 0; ; offset += commitsPageSize {
+		query := url.Values{
+			"limit":  {strconv.Itoa(commitsPageSize)},
+			"offset": {strconv.Itoa(offset)},
+		}
+
+		response := new(commitsResponse)
+		if err := cli.Call(ctx, http.MethodGet, commitsPath+"?"+query.Encode(), nil, response); err != nil {
+			return err
+		}
+
+		for _, commit := range response.Items {
+			if visited[commit.SHA] {
+				continue
+			}
+
+			visited[commit.SHA] = true
+
+			more, err := visit(commit)
+			if err != nil || !more {
+				return err
+			}
+		}
+
+		if len(response.Items) < commitsPageSize {
+			return nil
+		}
+	}
+}
+
+// withCommitChanges returns the commit with its changes. Commits are listed without their changes, so
+// these are then read from the commit itself. Every commit has at least one change.
+func (cli *Client) withCommitChanges(ctx context.Context, commit client.Commit) (client.Commit, error) {
+	if len(commit.Changes) != 0 {
+		return commit, nil
+	}
+
+	commitExtended, err := cli.GetCommit(ctx, commit.SHA)
+	if err != nil {
+		return commit, err
+	}
+
+	commit.Changes = commitExtended.Changes
+
+	return commit, nil
+}
+
+// commitBundles returns the names of the bundles changed by the commit, without duplicates.
+func commitBundles(commit client.Commit) []string {
+	bundles := []string{}
+
+	for _, change := range commit.Changes {
+		if !slices.Contains(bundles, string(change.BundleName)) {
+			bundles = append(bundles, string(change.BundleName))
+		}
+	}
+
+	return bundles
+}
+
+// commitChangesEntity reports if the commit changes the configuration of the given node or tag.
+func commitChangesEntity(commit client.Commit, entityType config.EntityType, entityID string) bool {
+	for _, change := range commit.Changes {
+		switch entityType {
+		case config.EntityTypeNode:
+			if change.NodeID == entityID {
+				return true
+			}
+		case config.EntityTypeTag:
+			if change.Tag == entityID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.qbee.io/client"
+	"go.qbee.io/client/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &configCommitsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &configCommitsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &configCommitsDataSource{}
+	_ datasource.DataSourceWithValidateConfig   = &configCommitsDataSource{}
+)
+
+const (
+	errorReadingCommits = "error reading commits"
+
+	// defaultCommitsLimit is the default maximum number of commits listed by the config commits data source.
+	defaultCommitsLimit = 100
+)
+
+// NewConfigCommitsDataSource is a helper function to simplify the provider implementation.
+func NewConfigCommitsDataSource() datasource.DataSource {
+	return &configCommitsDataSource{
+		dataSourceBase: newDataSourceBase("config_commits"),
+	}
+}
+
+type configCommitsDataSource struct {
+	dataSourceBase
+}
+
+type configCommitsDataSourceModel struct {
+	Node            types.String   `tfsdk:"node"`
+	Tag             types.String   `tfsdk:"tag"`
+	Author          types.String   `tfsdk:"author"`
+	MessageContains types.String   `tfsdk:"message_contains"`
+	Since           types.String   `tfsdk:"since"`
+	Until           types.String   `tfsdk:"until"`
+	Limit           types.Int64    `tfsdk:"limit"`
+	Commits         []commitModel  `tfsdk:"commits"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// commitModel describes a commit of the configuration.
+type commitModel struct {
+	SHA     types.String `tfsdk:"sha"`
+	Message types.String `tfsdk:"message"`
+	Author  types.String `tfsdk:"author"`
+	Created types.Int64  `tfsdk:"created"`
+	Bundles []string     `tfsdk:"bundles"`
+}
+
+// newCommitModel converts a commit to its model.
+func newCommitModel(commit client.Commit) commitModel {
+	return commitModel{
+		SHA:     types.StringValue(commit.SHA),
+		Message: types.StringValue(commit.Message),
+		Author:  types.StringValue(commit.Author),
+		Created: types.Int64Value(commit.Created),
+		Bundles: commitBundles(commit),
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *configCommitsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Config commits lists the commits of the configuration, newest first, optionally filtered. " +
+			"All filters must match.",
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the commits changing the configuration of this node.",
+			},
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the commits changing the configuration of this tag.",
+			},
+			"author": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the commits made by this author.",
+			},
+			"message_contains": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the commits with a message containing this text.",
+			},
+			"since": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the commits created at or after this time, in RFC 3339 format.",
+			},
+			"until": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the commits created at or before this time, in RFC 3339 format.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("Maximum number of commits to list. Listing stops once this "+
+					"number of matching commits is found. Defaults to `%d`.", defaultCommitsLimit),
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"commits": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching commits, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sha": schema.StringAttribute{
+							Computed:    true,
+							Description: "The SHA of the commit.",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "The message of the commit.",
+						},
+						"author": schema.StringAttribute{
+							Computed:    true,
+							Description: "The author of the commit.",
+						},
+						"created": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The creation time of the commit, as a Unix timestamp.",
+						},
+						"bundles": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The names of the configuration bundles changed by the commit.",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+func (d *configCommitsDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("tag"),
+			path.MatchRoot("node"),
+		),
+	}
+}
+
+// ValidateConfig checks that the time range is made of valid RFC 3339 times.
+func (d *configCommitsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	for _, attribute := range []string{"since", "until"} {
+		var value types.String
+		if resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid "+attribute, err.Error())
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *configCommitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state configCommitsDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, d.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	filter, err := state.filter()
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadingCommits, err.Error())
+		return
+	}
+
+	limit := defaultCommitsLimit
+	if !state.Limit.IsNull() {
+		limit = int(state.Limit.ValueInt64())
+	}
+
+	state.Commits = []commitModel{}
+
+	err = d.client.listCommits(ctx, func(commit client.Commit) (bool, error) {
+		match, more := filter(commit)
+		if !match {
+			return more, nil
+		}
+
+		// Reading the changes takes a request per commit, so it is only done for commits matching the other filters
+		commit, err := d.client.withCommitChanges(ctx, commit)
+		if err != nil {
+			return false, err
+		}
+
+		if state.changesEntity(commit) {
+			state.Commits = append(state.Commits, newCommitModel(commit))
+		}
+
+		return more && len(state.Commits) < limit, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadingCommits, "error listing the commits: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// filter returns a function that reports if a commit matches the time range, author and message filters of the
+// model, and if older commits can still match. Commits are listed newest first, so listing can stop at the first
+// commit before the time range. The node and tag filters need the changes of the commit, see changesEntity.
+func (m configCommitsDataSourceModel) filter() (func(client.Commit) (match bool, more bool), error) {
+	var since, until int64
+	if !m.Since.IsNull() {
+		t, err := time.Parse(time.RFC3339, m.Since.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		since = t.Unix()
+	}
+
+	if !m.Until.IsNull() {
+		t, err := time.Parse(time.RFC3339, m.Until.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		until = t.Unix()
+	}
+
+	return func(c client.Commit) (bool, bool) {
+		if !m.Since.IsNull() && c.Created < since {
+			return false, false
+		}
+
+		if !m.Until.IsNull() && c.Created > until {
+			return false, true
+		}
+
+		if !m.Author.IsNull() && c.Author != m.Author.ValueString() {
+			return false, true
+		}
+
+		if !m.MessageContains.IsNull() && !strings.Contains(c.Message, m.MessageContains.ValueString()) {
+			return false, true
+		}
+
+		return true, true
+	}, nil
+}
+
+// changesEntity reports if the commit changes the configuration of the node or tag of the model, if set.
+func (m configCommitsDataSourceModel) changesEntity(commit client.Commit) bool {
+	if !m.Node.IsNull() {
+		return commitChangesEntity(commit, config.EntityTypeNode, m.Node.ValueString())
+	}
+
+	if !m.Tag.IsNull() {
+		return commitChangesEntity(commit, config.EntityTypeTag, m.Tag.ValueString())
+	}
+
+	return true
+}
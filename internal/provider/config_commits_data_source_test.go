@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.qbee.io/client"
+)
+
+func TestAccConfigCommitsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "qbee_ntp" "test" {
+  tag = "terraform:acctest:config_commits"
+  extend = true
+  servers = ["0.pool.ntp.org"]
+  commit_message = "terraform acctest config commits"
+}
+
+data "qbee_config_commits" "test" {
+  tag = qbee_ntp.test.tag
+  message_contains = "acctest config commits"
+  since = timeadd(timestamp(), "-1h")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.qbee_config_commits.test", "commits.#", "1"),
+					resource.TestCheckResourceAttrPair("data.qbee_config_commits.test", "commits.0.sha",
+						"qbee_ntp.test", "last_commit_sha"),
+					resource.TestCheckResourceAttr("data.qbee_config_commits.test", "commits.0.bundles.#", "1"),
+					resource.TestCheckResourceAttr("data.qbee_config_commits.test", "commits.0.bundles.0", "ntp"),
+				),
+			},
+		},
+	})
+}
+
+func TestConfigCommitsFilter(t *testing.T) {
+	// The entity of a change is set on the change, next to the bundle data in its content.
+	// The commit was created at 2023-11-14T22:13:20Z.
+	payload := `{
+		"sha": "abc",
+		"message": "Update the NTP servers",
+		"author": "ops@example.com",
+		"created": 1700000000,
+		"changes": [
+			{"sha": "c1", "tag": "production", "bundle_name": "ntp", "content": {"enabled": true, "servers": []}},
+			{"sha": "c2", "node_id": "device-1", "bundle_name": "ntp", "content": {"enabled": true, "servers": []}},
+			{"sha": "c3", "node_id": "device-1", "bundle_name": "firewall", "content": {"enabled": true}}
+		]
+	}`
+
+	var commit client.Commit
+	if err := json.Unmarshal([]byte(payload), &commit); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		model     configCommitsDataSourceModel
+		wantMatch bool
+		wantMore  bool
+	}{
+		{name: "no filters", wantMatch: true, wantMore: true},
+		{name: "author", model: configCommitsDataSourceModel{Author: types.StringValue("ops@example.com")}, wantMatch: true, wantMore: true},
+		{name: "other author", model: configCommitsDataSourceModel{Author: types.StringValue("dev@example.com")}, wantMore: true},
+		{name: "message", model: configCommitsDataSourceModel{MessageContains: types.StringValue("NTP")}, wantMatch: true, wantMore: true},
+		{name: "other message", model: configCommitsDataSourceModel{MessageContains: types.StringValue("firewall")}, wantMore: true},
+		{name: "in range", model: configCommitsDataSourceModel{
+			Since: types.StringValue("2023-11-14T00:00:00Z"),
+			Until: types.StringValue("2023-11-15T00:00:00Z"),
+		}, wantMatch: true, wantMore: true},
+		{name: "after range", model: configCommitsDataSourceModel{Until: types.StringValue("2023-11-14T00:00:00Z")}, wantMore: true},
+		{name: "before range", model: configCommitsDataSourceModel{Since: types.StringValue("2023-11-15T00:00:00Z")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := tt.model.filter()
+			if err != nil {
+				t.Fatalf("filter() error = %v", err)
+			}
+
+			match, more := filter(commit)
+			if match != tt.wantMatch || more != tt.wantMore {
+				t.Errorf("filter() = %v, %v, want %v, %v", match, more, tt.wantMatch, tt.wantMore)
+			}
+		})
+	}
+
+	entityTests := []struct {
+		name  string
+		model configCommitsDataSourceModel
+		want  bool
+	}{
+		{name: "no entity", want: true},
+		{name: "node", model: configCommitsDataSourceModel{Node: types.StringValue("device-1")}, want: true},
+		{name: "other node", model: configCommitsDataSourceModel{Node: types.StringValue("device-2")}},
+		{name: "tag", model: configCommitsDataSourceModel{Tag: types.StringValue("production")}, want: true},
+		{name: "node as tag", model: configCommitsDataSourceModel{Tag: types.StringValue("device-1")}},
+	}
+
+	for _, tt := range entityTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.model.changesEntity(commit); got != tt.want {
+				t.Errorf("changesEntity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if bundles := commitBundles(commit); !reflect.DeepEqual(bundles, []string{"ntp", "firewall"}) {
+		t.Errorf("commitBundles() = %v, want [ntp firewall]", bundles)
+	}
+}
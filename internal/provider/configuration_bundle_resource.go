@@ -0,0 +1,519 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.qbee.io/client/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resourceModelManager                  = &configurationBundleResourceModel{}
+	_ resource.Resource                     = &configurationBundleResource{}
+	_ resource.ResourceWithConfigure        = &configurationBundleResource{}
+	_ resource.ResourceWithConfigValidators = &configurationBundleResource{}
+	_ resource.ResourceWithValidateConfig   = &configurationBundleResource{}
+	_ resource.ResourceWithImportState      = &configurationBundleResource{}
+)
+
+// bundleMetadataKeys are the keys of the bundle content that hold the metadata of the configuration,
+// which is managed using the extend and enabled attributes.
+var bundleMetadataKeys = []string{"enabled", "extend", "version", "reset"}
+
+// NewConfigurationBundleResource is a helper function to simplify the provider implementation.
+func NewConfigurationBundleResource() resource.Resource {
+	return &configurationBundleResource{
+		configurationResource: configurationResource{
+			resourceBase: newResourceBase("configuration_bundle"),
+			modelFactory: func() any {
+				return new(configurationBundleResourceModel)
+			},
+		},
+	}
+}
+
+// configurationBundleResource manages any configuration bundle using its JSON content, including bundles
+// and fields that are not modelled by the other configuration resources.
+//
+// Unlike the other configuration resources, the bundle is chosen by the user, so its model can't be
+// created without the state. Create, Read and Update are therefore implemented by the resource itself,
+// while Delete and the configuration validators are shared with the other configuration resources.
+type configurationBundleResource struct {
+	configurationResource
+}
+
+// Schema defines the schema for the resource.
+func (r *configurationBundleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configuration bundle manages any configuration bundle using its raw JSON content. It allows " +
+			"to use bundles and fields that have no dedicated resource yet. A bundle must not be managed by both " +
+			"this resource and its dedicated resource for the same node or tag.",
+		Attributes: map[string]schema.Attribute{
+			"bundle": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the configuration bundle, for example firewall or ntp.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"tag": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The tag for which to set the configuration. Either tag or node is required.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"node": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The node for which to set the configuration. Either tag or node is required.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"extend": schema.BoolAttribute{
+				Required: true,
+				Description: "If the configuration should extend configuration from the parent nodes of the node " +
+					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
+			},
+			"enabled": enabledAttribute(),
+			"content": schema.StringAttribute{
+				Required:   true,
+				CustomType: jsontypes.NormalizedType{},
+				Description: "The content of the bundle as a JSON object, without the enabled, extend, version and " +
+					"reset metadata. Only the keys set in the content are checked for drift: changed and removed " +
+					"keys are detected, but keys set outside of Terraform that are not in the content are not " +
+					"compared. They are replaced by the content when the bundle is next updated.",
+			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
+		},
+	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes, "bundle")
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
+}
+
+// ValidateConfig checks that the content is a JSON object without metadata keys.
+func (r *configurationBundleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var content jsontypes.Normalized
+	if resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content"), &content)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if content.IsNull() || content.IsUnknown() {
+		return
+	}
+
+	object, err := decodeJSONObject(content.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid bundle content", err.Error())
+		return
+	}
+
+	for _, key := range bundleMetadataKeys {
+		if _, found := object[key]; found {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content"),
+				"Invalid bundle content",
+				fmt.Sprintf("The content must not contain the %q metadata key, which is managed by the provider.", key),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *configurationBundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.checkReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
+	var model configurationBundleResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := model.Timeouts.Create(ctx, r.client.defaultTimeouts.Create)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	r.commit(ctx, &model, &resp.State, &resp.Diagnostics, "creating")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *configurationBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
+	if onlyCommitMessageChanged(req.Plan, req.State) {
+		keepConfiguration(ctx, req, resp)
+		return
+	}
+
+	var model configurationBundleResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := model.Timeouts.Update(ctx, r.client.defaultTimeouts.Update)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.commit(ctx, &model, &resp.State, &resp.Diagnostics, "updating")
+}
+
+// commit commits the configuration of the model and sets the state to match it.
+func (r *configurationBundleResource) commit(
+	ctx context.Context,
+	model *configurationBundleResourceModel,
+	state *tfsdk.State,
+	diags *diag.Diagnostics,
+	operation string,
+) {
+	commit, err := r.client.commitConfiguration(ctx, model, false)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error %s %s configuration", operation, model.Bundle.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	model.setLastCommit(commit)
+
+	effective, effectiveDiags := r.readEffectiveBundle(ctx, *state, model)
+	diags.Append(warnOnError(effectiveDiags)...)
+	model.setEffective(effective)
+
+	diags.Append(state.Set(ctx, model)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *configurationBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model configurationBundleResourceModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &model)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := model.Timeouts.Read(ctx, r.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	bundle := model.getConfigBundle()
+
+	// The raw configuration is read, as the API client only decodes the bundles and keys it knows
+	activeConfig, err := r.client.getRawActiveConfig(ctx, model.getEntityType(), model.getEntityID(), config.EntityConfigScopeOwn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading %s configuration", bundle),
+			err.Error())
+
+		return
+	}
+
+	// Remove the resource from the state if the active configuration does not contain the bundle
+	if !slices.Contains(activeConfig.Bundles, bundle) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	desired := model.Content
+	if err := model.fromRawBundleData(activeConfig.BundleData); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error parsing %s configuration", bundle),
+			err.Error(),
+		)
+		return
+	}
+
+	// Only compare the keys managed by the resource, so that defaults added by the API don't show up as drift
+	if !desired.IsNull() && !model.Content.IsNull() {
+		if model.Content, err = projectBundleContent(model.Content, desired); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error parsing %s configuration", bundle),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if model.Content.IsNull() {
+		tflog.Warn(ctx, fmt.Sprintf("The active configuration has no data for the %s bundle, its content is not checked for drift", bundle))
+		model.Content = desired
+	}
+
+	// The effective configuration is informational, so failing to read it must not fail the refresh
+	effective, diags := r.readEffectiveBundle(ctx, resp.State, &model)
+	resp.Diagnostics.Append(warnOnError(diags)...)
+	model.setEffective(effective)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// readEffectiveBundle reads the content of the bundle of the model merged with the configuration of the
+// parent nodes, see configurationResource.readEffective.
+func (r *configurationBundleResource) readEffectiveBundle(
+	ctx context.Context,
+	state tfsdk.State,
+	model *configurationBundleResourceModel,
+) (types.Object, diag.Diagnostics) {
+	effectiveType, diags := effectiveObjectType(ctx, state)
+	if diags.HasError() {
+		return types.ObjectNull(nil), diags
+	}
+
+	null := types.ObjectNull(effectiveType.AttrTypes)
+	bundle := model.getConfigBundle()
+
+	if !model.ReadEffective.ValueBool() {
+		return null, diags
+	}
+
+	activeConfig, err := r.client.getRawActiveConfig(ctx, model.getEntityType(), model.getEntityID(), config.EntityConfigScopeAll)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading effective %s configuration", bundle),
+			err.Error(),
+		)
+		return null, diags
+	}
+
+	if !slices.Contains(activeConfig.Bundles, bundle) {
+		return null, diags
+	}
+
+	effective := configurationBundleResourceModel{Bundle: model.Bundle}
+	if err := effective.fromRawBundleData(activeConfig.BundleData); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error parsing effective %s configuration", bundle),
+			err.Error(),
+		)
+		return null, diags
+	}
+
+	if effective.Content.IsNull() {
+		return null, diags
+	}
+
+	value := struct {
+		Enabled types.Bool           `tfsdk:"enabled"`
+		Content jsontypes.Normalized `tfsdk:"content"`
+	}{
+		Enabled: effective.Enabled,
+		Content: effective.Content,
+	}
+
+	object, objectDiags := types.ObjectValueFrom(ctx, effectiveType.AttrTypes, value)
+	diags.Append(objectDiags...)
+
+	return object, diags
+}
+
+// ImportState imports the resource using an identifier of the form bundle:type:identifier.
+func (r *configurationBundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bundle, entity, found := strings.Cut(req.ID, ":")
+	if !found || bundle == "" {
+		resp.Diagnostics.AddError(
+			"Error importing configuration_bundle",
+			fmt.Sprintf("Expected import identifier with format: bundle:type:identifier. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bundle"), bundle)...)
+
+	req.ID = entity
+	r.configurationResource.ImportState(ctx, req, resp)
+}
+
+type configurationBundleResourceModel struct {
+	configurationResourceModel
+	Bundle  types.String         `tfsdk:"bundle"`
+	Content jsontypes.Normalized `tfsdk:"content"`
+}
+
+func (m configurationBundleResourceModel) getConfigBundle() config.Bundle {
+	return config.Bundle(m.Bundle.ValueString())
+}
+
+// fromBundleData sets the content of the model from the bundle data decoded by the API client. The content
+// is null if the bundle is not known to the API client, and keys unknown to it are left out, so the
+// resource reads the raw bundle data using fromRawBundleData instead.
+func (m *configurationBundleResourceModel) fromBundleData(bundleData config.BundleData) error {
+	data, err := json.Marshal(bundleData)
+	if err != nil {
+		return err
+	}
+
+	var bundles map[string]json.RawMessage
+	if err := json.Unmarshal(data, &bundles); err != nil {
+		return err
+	}
+
+	return m.fromRawBundleData(bundles)
+}
+
+// fromRawBundleData sets the content of the model from the raw bundle data, by bundle name.
+// The content is null if the bundle data has no data for the bundle.
+func (m *configurationBundleResourceModel) fromRawBundleData(bundles map[string]json.RawMessage) error {
+	bundleJSON, found := bundles[m.Bundle.ValueString()]
+	if !found || string(bundleJSON) == "null" {
+		m.Content = jsontypes.NewNormalizedNull()
+		return nil
+	}
+
+	content, err := decodeJSONObject(string(bundleJSON))
+	if err != nil {
+		return err
+	}
+
+	var metadata config.Metadata
+	if err := json.Unmarshal(bundleJSON, &metadata); err != nil {
+		return err
+	}
+
+	m.Extend = types.BoolValue(metadata.Extend)
+	m.Enabled = types.BoolValue(metadata.Enabled)
+
+	for _, key := range bundleMetadataKeys {
+		delete(content, key)
+	}
+
+	contentJSON, err := encodeJSON(content)
+	if err != nil {
+		return err
+	}
+
+	m.Content = jsontypes.NewNormalizedValue(contentJSON)
+
+	return nil
+}
+
+func (m configurationBundleResourceModel) toBundleData(metadata config.Metadata) any {
+	bundleData := make(map[string]any)
+
+	// The content is validated to be a JSON object, and is not needed to reset the bundle
+	if !metadata.Reset {
+		if content, err := decodeJSONObject(m.Content.ValueString()); err == nil {
+			bundleData = content
+		}
+	}
+
+	metadataJSON, _ := json.Marshal(metadata)
+	_ = json.Unmarshal(metadataJSON, &bundleData)
+
+	return bundleData
+}
+
+// decodeJSONObject decodes a JSON object, keeping numbers as they are written.
+func decodeJSONObject(data string) (map[string]any, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+
+	var object map[string]any
+	if err := decoder.Decode(&object); err != nil {
+		return nil, fmt.Errorf("the content must be a JSON object: %w", err)
+	}
+
+	if object == nil {
+		return nil, fmt.Errorf("the content must be a JSON object, got null")
+	}
+
+	return object, nil
+}
+
+// encodeJSON encodes the value as compact JSON with sorted object keys, without escaping HTML characters.
+func encodeJSON(value any) (string, error) {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// projectBundleContent returns the actual content limited to the keys of the desired content.
+func projectBundleContent(actual, desired jsontypes.Normalized) (jsontypes.Normalized, error) {
+	actualObject, err := decodeJSONObject(actual.ValueString())
+	if err != nil {
+		return actual, err
+	}
+
+	desiredObject, err := decodeJSONObject(desired.ValueString())
+	if err != nil {
+		return actual, err
+	}
+
+	projected, err := encodeJSON(projectJSON(actualObject, desiredObject))
+	if err != nil {
+		return actual, err
+	}
+
+	return jsontypes.NewNormalizedValue(projected), nil
+}
+
+// projectJSON returns the actual JSON value limited to the object keys of the desired value.
+// Keys of the desired value that are missing in the actual value stay missing, so that keys removed
+// outside of Terraform show up as drift. Lists are compared element by element.
+func projectJSON(actual, desired any) any {
+	switch desired := desired.(type) {
+	case map[string]any:
+		actualObject, ok := actual.(map[string]any)
+		if !ok {
+			return actual
+		}
+
+		projected := make(map[string]any, len(desired))
+		for key, desiredValue := range desired {
+			if actualValue, found := actualObject[key]; found {
+				projected[key] = projectJSON(actualValue, desiredValue)
+			}
+		}
+
+		return projected
+	case []any:
+		actualList, ok := actual.([]any)
+		if !ok {
+			return actual
+		}
+
+		projected := make([]any, len(actualList))
+		for i, actualValue := range actualList {
+			if i < len(desired) {
+				projected[i] = projectJSON(actualValue, desired[i])
+			} else {
+				projected[i] = actualValue
+			}
+		}
+
+		return projected
+	default:
+		return actual
+	}
+}
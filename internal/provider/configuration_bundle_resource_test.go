@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.qbee.io/client/config"
+)
+
+func TestAccConfigurationBundleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "qbee_configuration_bundle" "test" {
+  bundle = "connectivity_watchdog"
+  tag    = "terraform:acctest:configurationbundle"
+  extend = false
+  read_effective = true
+
+  content = jsonencode({
+    threshold = "5"
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_configuration_bundle.test", "bundle", "connectivity_watchdog"),
+					resource.TestCheckResourceAttr("qbee_configuration_bundle.test", "tag", "terraform:acctest:configurationbundle"),
+					resource.TestCheckNoResourceAttr("qbee_configuration_bundle.test", "node"),
+					resource.TestCheckResourceAttr("qbee_configuration_bundle.test", "extend", "false"),
+					resource.TestCheckResourceAttr("qbee_configuration_bundle.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("qbee_configuration_bundle.test", "content", `{"threshold":"5"}`),
+					resource.TestCheckResourceAttr("qbee_configuration_bundle.test", "effective.content", `{"threshold":"5"}`),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:                         "qbee_configuration_bundle.test",
+				ImportState:                          true,
+				ImportStateId:                        "connectivity_watchdog:tag:terraform:acctest:configurationbundle",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created", "read_effective", "effective"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "qbee_configuration_bundle" "test" {
+  bundle = "connectivity_watchdog"
+  tag    = "terraform:acctest:configurationbundle"
+  extend = true
+  read_effective = true
+
+  content = <<-EOT
+    { "threshold": "3" }
+  EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_configuration_bundle.test", "extend", "true"),
+					resource.TestCheckResourceAttr("qbee_configuration_bundle.test", "effective.content", `{"threshold":"3"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestConfigurationBundleResourceModelFromBundleData(t *testing.T) {
+	model := configurationBundleResourceModel{Bundle: types.StringValue("connectivity_watchdog")}
+
+	err := model.fromBundleData(config.BundleData{
+		ConnectivityWatchdog: &config.ConnectivityWatchdog{
+			Metadata:  config.Metadata{Enabled: false, Extend: true, Version: "v1"},
+			Threshold: "3",
+		},
+	})
+	if err != nil {
+		t.Fatalf("fromBundleData() error = %v", err)
+	}
+
+	if got, want := model.Content.ValueString(), `{"threshold":"3"}`; got != want {
+		t.Errorf("content = %s, want %s", got, want)
+	}
+
+	if !model.Extend.ValueBool() || model.Enabled.ValueBool() {
+		t.Errorf("extend = %s, enabled = %s, want true and false", model.Extend, model.Enabled)
+	}
+
+	// Bundles that are not known to the API client have no content
+	model.Bundle = types.StringValue("ntp")
+	if err := model.fromBundleData(config.BundleData{}); err != nil {
+		t.Fatalf("fromBundleData() error = %v", err)
+	}
+
+	if !model.Content.IsNull() {
+		t.Errorf("content = %s, want null", model.Content)
+	}
+}
+
+func TestConfigurationBundleResourceModelFromRawBundleData(t *testing.T) {
+	// The grace_period key and the future_bundle bundle are not known to the API client
+	payload := `{
+		"bundles": ["connectivity_watchdog", "future_bundle"],
+		"bundle_data": {
+			"connectivity_watchdog": {"enabled": true, "extend": false, "version": "v1", "threshold": "5", "grace_period": 30},
+			"future_bundle": {"enabled": true, "extend": true, "version": "v1", "items": [{"name": "a"}]}
+		}
+	}`
+
+	var activeConfig rawActiveConfig
+	if err := json.Unmarshal([]byte(payload), &activeConfig); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	tests := []struct {
+		bundle string
+		want   string
+	}{
+		{bundle: "connectivity_watchdog", want: `{"grace_period":30,"threshold":"5"}`},
+		{bundle: "future_bundle", want: `{"items":[{"name":"a"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.bundle, func(t *testing.T) {
+			model := configurationBundleResourceModel{Bundle: types.StringValue(tt.bundle)}
+			if err := model.fromRawBundleData(activeConfig.BundleData); err != nil {
+				t.Fatalf("fromRawBundleData() error = %v", err)
+			}
+
+			if got := model.Content.ValueString(); got != tt.want {
+				t.Errorf("content = %s, want %s", got, tt.want)
+			}
+
+			if !model.Enabled.ValueBool() {
+				t.Errorf("enabled = %s, want true", model.Enabled)
+			}
+		})
+	}
+}
+
+func TestConfigurationBundleResourceModelToBundleData(t *testing.T) {
+	model := configurationBundleResourceModel{
+		Bundle:  types.StringValue("ntp"),
+		Content: jsontypes.NewNormalizedValue(`{"servers": [{"host": "pool.ntp.org"}], "interval": 12345678901234567}`),
+	}
+
+	tests := []struct {
+		name     string
+		metadata config.Metadata
+		want     string
+	}{
+		{
+			name:     "set",
+			metadata: config.Metadata{Enabled: true, Extend: true, Version: "v1"},
+			want: `{"enabled":true,"extend":true,"interval":12345678901234567,` +
+				`"servers":[{"host":"pool.ntp.org"}],"version":"v1"}`,
+		},
+		{
+			name:     "reset",
+			metadata: config.Metadata{Reset: true, Version: "v1"},
+			want:     `{"enabled":false,"extend":false,"reset":true,"version":"v1"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(model.toBundleData(tt.metadata))
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("toBundleData() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		actual  string
+		desired string
+		want    string
+	}{
+		{
+			name:    "keys added by the API are ignored",
+			actual:  `{"threshold":"3","items":null}`,
+			desired: `{"threshold":"3"}`,
+			want:    `{"threshold":"3"}`,
+		},
+		{
+			name:    "changed values are kept",
+			actual:  `{"threshold":"5"}`,
+			desired: `{"threshold":"3"}`,
+			want:    `{"threshold":"5"}`,
+		},
+		{
+			name:    "keys removed outside of Terraform are missing",
+			actual:  `{"threshold":"3"}`,
+			desired: `{"threshold":"3","removed_field":true}`,
+			want:    `{"threshold":"3"}`,
+		},
+		{
+			name:    "lists are projected element by element",
+			actual:  `{"items":[{"name":"a","args":""},{"name":"b","args":""}]}`,
+			desired: `{"items":[{"name":"a"}]}`,
+			want:    `{"items":[{"name":"a"},{"name":"b","args":""}]}`,
+		},
+		{
+			name:    "type changes are kept",
+			actual:  `{"items":"none"}`,
+			desired: `{"items":[{"name":"a"}]}`,
+			want:    `{"items":"none"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := projectBundleContent(jsontypes.NewNormalizedValue(tt.actual), jsontypes.NewNormalizedValue(tt.desired))
+			if err != nil {
+				t.Fatalf("projectBundleContent() error = %v", err)
+			}
+
+			var gotValue, wantValue any
+			_ = json.Unmarshal([]byte(got.ValueString()), &gotValue)
+			_ = json.Unmarshal([]byte(tt.want), &wantValue)
+
+			if !reflect.DeepEqual(gotValue, wantValue) {
+				t.Errorf("projectBundleContent() = %s, want %s", got.ValueString(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONObject(t *testing.T) {
+	for _, content := range []string{`[]`, `null`, `"text"`, `{"a":`} {
+		if _, err := decodeJSONObject(content); err == nil {
+			t.Errorf("decodeJSONObject(%s) error = nil, want an error", content)
+		}
+	}
+}
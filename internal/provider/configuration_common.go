@@ -6,11 +6,18 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.qbee.io/client"
 	"go.qbee.io/client/config"
 )
 
@@ -22,6 +29,111 @@ func nullableStringValue(value string) basetypes.StringValue {
 	}
 }
 
+// configurationTimeoutsAttribute returns the schema of the timeouts attribute shared by all configuration resources.
+func configurationTimeoutsAttribute(ctx context.Context) schema.Attribute {
+	return timeouts.Attributes(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// enabledAttribute returns the schema of the enabled attribute shared by all configuration resources.
+func enabledAttribute() schema.Attribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(true),
+		Description: "If the configuration is enabled. A disabled configuration is kept, but not applied to the " +
+			"devices. Defaults to true.",
+	}
+}
+
+// commitMessageAttribute returns the schema of the commit_message attribute shared by all configuration resources.
+func commitMessageAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Description: "The message of the commits creating or updating the configuration, for example a reference " +
+			"to the pull request making the change. Defaults to a message generated by the provider. Changing only " +
+			"the message does not create a commit; the new message is used by the next change of the configuration.",
+	}
+}
+
+// commitOnlyAttributes are the attributes of configuration resources that can change without changing the
+// configuration itself. An update changing only these attributes does not create a commit.
+var commitOnlyAttributes = []string{
+	"commit_message",
+	"timeouts",
+	"read_effective",
+	"last_commit_sha",
+	"last_commit_created",
+	"effective",
+}
+
+// onlyCommitMessageChanged reports if the plan of an update only changes the commit message, read_effective or
+// the timeouts.
+// Committing the unchanged configuration again would only create an empty commit, so the message is kept in
+// the state and used by the next commit changing the configuration.
+func onlyCommitMessageChanged(plan tfsdk.Plan, state tfsdk.State) bool {
+	var planValues, stateValues map[string]tftypes.Value
+	if plan.Raw.As(&planValues) != nil || state.Raw.As(&stateValues) != nil {
+		return false
+	}
+
+	for name, value := range planValues {
+		if slices.Contains(commitOnlyAttributes, name) {
+			continue
+		}
+
+		// Computed attributes are unknown in the plan when the update changes them, for example a secret hash
+		if !value.Equal(stateValues[name]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// keepConfiguration sets the state of an update that only changes the commit message, see onlyCommitMessageChanged.
+// The state is kept, with the commit message, read_effective and timeouts of the plan. The effective
+// configuration is read again by the next refresh. The private state is kept by the framework.
+func keepConfiguration(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var commitMessage types.String
+	var readEffective types.Bool
+	var operationTimeouts timeouts.Value
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("commit_message"), &commitMessage)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("read_effective"), &readEffective)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &operationTimeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Only the commit message changed, keeping it for the next commit")
+
+	resp.State.Raw = req.State.Raw.Copy()
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("commit_message"), commitMessage)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("read_effective"), readEffective)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), operationTimeouts)...)
+}
+
+// lastCommitSHAAttribute returns the schema of the last_commit_sha attribute shared by all configuration resources.
+func lastCommitSHAAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Computed:    true,
+		Description: "The SHA of the last commit made by Terraform to create or update the configuration.",
+	}
+}
+
+// lastCommitCreatedAttribute returns the schema of the last_commit_created attribute shared by all configuration resources.
+func lastCommitCreatedAttribute() schema.Attribute {
+	return schema.Int64Attribute{
+		Computed:    true,
+		Description: "The creation time of the last commit made by Terraform, as a Unix timestamp.",
+	}
+}
+
 // configurationResource is a base struct that is embedded in all configuration resources.
 type configurationResource struct {
 	resourceBase
@@ -43,6 +155,10 @@ func (r *configurationResource) ConfigValidators(ctx context.Context) []resource
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *configurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.checkReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
 	model := r.modelFactory()
 
 	// Get the model from the plan
@@ -50,8 +166,17 @@ func (r *configurationResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := model.(resourceModelManager).getBaseResourceModel().Timeouts.Create(ctx, r.client.defaultTimeouts.Create)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Commit the configuration change
-	if _, err := r.client.commitConfiguration(ctx, model.(resourceModelManager), false); err != nil {
+	commit, err := r.client.commitConfiguration(ctx, model.(resourceModelManager), false)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error creating %s configuration", r.name),
 			err.Error(),
@@ -59,12 +184,27 @@ func (r *configurationResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	model.(resourceModelManager).setLastCommit(commit)
+
+	effective, diags := r.readEffective(ctx, resp.State, model.(resourceModelManager))
+	resp.Diagnostics.Append(warnOnError(diags)...)
+	model.(resourceModelManager).setEffective(effective)
+
 	// If commit was successful, we can assume the state now reflects the desired configuration, so we set the state to match the plan
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *configurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
+	if onlyCommitMessageChanged(req.Plan, req.State) {
+		keepConfiguration(ctx, req, resp)
+		return
+	}
+
 	model := r.modelFactory()
 
 	// Get the model from the plan
@@ -72,8 +212,17 @@ func (r *configurationResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := model.(resourceModelManager).getBaseResourceModel().Timeouts.Update(ctx, r.client.defaultTimeouts.Update)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Commit the configuration change
-	if _, err := r.client.commitConfiguration(ctx, model.(resourceModelManager), false); err != nil {
+	commit, err := r.client.commitConfiguration(ctx, model.(resourceModelManager), false)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error updating %s configuration", r.name),
 			err.Error(),
@@ -81,6 +230,12 @@ func (r *configurationResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	model.(resourceModelManager).setLastCommit(commit)
+
+	effective, diags := r.readEffective(ctx, resp.State, model.(resourceModelManager))
+	resp.Diagnostics.Append(warnOnError(diags)...)
+	model.(resourceModelManager).setEffective(effective)
+
 	// If commit was successful, we can assume the state now reflects the desired configuration, so we set the state to match the plan
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
@@ -88,13 +243,27 @@ func (r *configurationResource) Update(ctx context.Context, req resource.UpdateR
 // Read refreshes the Terraform state with the latest data.
 func (r *configurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var nodeID, tag *string
+	var state configurationResourceModel
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("node"), &nodeID)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tag"), &tag)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("commit_message"), &state.CommitMessage)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("last_commit_sha"), &state.LastCommitSHA)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("last_commit_created"), &state.LastCommitCreated)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("read_effective"), &state.ReadEffective)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	var entityType config.EntityType
 	var entityID string
 
@@ -125,6 +294,7 @@ func (r *configurationResource) Read(ctx context.Context, req resource.ReadReque
 	model := r.modelFactory()
 	resourceManager := model.(resourceModelManager)
 	resourceManager.setEntityID(activeConfig.Type, activeConfig.EntityID)
+	resourceManager.setStateOnlyAttributes(state)
 
 	// Remove the resource from the state if the active configuration does not contain the relevant bundle
 	if !slices.Contains(activeConfig.Bundles, resourceManager.getConfigBundle()) {
@@ -141,11 +311,20 @@ func (r *configurationResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	// The effective configuration is informational, so failing to read it must not fail the refresh
+	effective, diags := r.readEffective(ctx, resp.State, resourceManager)
+	resp.Diagnostics.Append(warnOnError(diags)...)
+	resourceManager.setEffective(effective)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *configurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &resp.Diagnostics) {
+		return
+	}
+
 	model := r.modelFactory()
 
 	// Get the model from the current state to identify which resource to reset
@@ -153,6 +332,14 @@ func (r *configurationResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, diags := model.(resourceModelManager).getBaseResourceModel().Timeouts.Delete(ctx, r.client.defaultTimeouts.Delete)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Commit the configuration change with reset=true.
 	if _, err := r.client.commitConfiguration(ctx, model.(resourceModelManager), true); err != nil {
 		resp.Diagnostics.AddError(
@@ -187,9 +374,18 @@ func (r *configurationResource) ImportState(ctx context.Context, req resource.Im
 
 // configurationResourceModel defines the common fields for all resources that are associated with a node or a tag.
 type configurationResourceModel struct {
-	Node   types.String `tfsdk:"node"`
-	Tag    types.String `tfsdk:"tag"`
-	Extend types.Bool   `tfsdk:"extend"`
+	Node     types.String   `tfsdk:"node"`
+	Tag      types.String   `tfsdk:"tag"`
+	Extend   types.Bool     `tfsdk:"extend"`
+	Enabled  types.Bool     `tfsdk:"enabled"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	CommitMessage     types.String `tfsdk:"commit_message"`
+	LastCommitSHA     types.String `tfsdk:"last_commit_sha"`
+	LastCommitCreated types.Int64  `tfsdk:"last_commit_created"`
+
+	ReadEffective types.Bool   `tfsdk:"read_effective"`
+	Effective     types.Object `tfsdk:"effective"`
 }
 
 // getBaseResourceModel returns the base resource model associated with the resource.
@@ -206,6 +402,32 @@ func (m *configurationResourceModel) setEntityID(entityType config.EntityType, e
 	}
 }
 
+// setStateOnlyAttributes copies the attributes that are not part of the bundle data from the given model.
+func (m *configurationResourceModel) setStateOnlyAttributes(state configurationResourceModel) {
+	m.Timeouts = state.Timeouts
+	m.CommitMessage = state.CommitMessage
+	m.LastCommitSHA = state.LastCommitSHA
+	m.LastCommitCreated = state.LastCommitCreated
+	m.ReadEffective = state.ReadEffective
+}
+
+// setLastCommit sets the last commit attributes of the model from the given commit.
+func (m *configurationResourceModel) setLastCommit(commit *client.Commit) {
+	if commit == nil {
+		m.LastCommitSHA = types.StringNull()
+		m.LastCommitCreated = types.Int64Null()
+		return
+	}
+
+	m.LastCommitSHA = types.StringValue(commit.SHA)
+	m.LastCommitCreated = types.Int64Value(commit.Created)
+}
+
+// setEffective sets the effective configuration of the model.
+func (m *configurationResourceModel) setEffective(effective types.Object) {
+	m.Effective = effective
+}
+
 // getEntityType returns the entity type (node or tag) associated with the resource model.
 func (m configurationResourceModel) getEntityType() config.EntityType {
 	if m.Tag.ValueString() != "" {
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOnlyCommitMessageChanged(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewNTPResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	effectiveType, _ := effectiveObjectType(ctx, state)
+	timeoutsType := schemaResp.Schema.Attributes["timeouts"].GetType().(timeouts.Type)
+
+	model := ntpResourceModel{
+		configurationResourceModel: configurationResourceModel{
+			Tag:               types.StringValue("test"),
+			Extend:            types.BoolValue(true),
+			Enabled:           types.BoolValue(true),
+			Timeouts:          timeouts.Value{Object: types.ObjectNull(timeoutsType.AttrTypes)},
+			CommitMessage:     types.StringValue("first message"),
+			LastCommitSHA:     types.StringValue("sha"),
+			LastCommitCreated: types.Int64Value(1700000000),
+			Effective:         types.ObjectNull(effectiveType.AttrTypes),
+		},
+		Servers: []types.String{types.StringValue("0.pool.ntp.org")},
+	}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("State.Set() diagnostics = %v", diags)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(plan *tfsdk.Plan)
+		want   bool
+	}{
+		{
+			name: "commit message",
+			modify: func(plan *tfsdk.Plan) {
+				plan.SetAttribute(ctx, path.Root("commit_message"), types.StringValue("second message"))
+				plan.SetAttribute(ctx, path.Root("last_commit_sha"), types.StringUnknown())
+				plan.SetAttribute(ctx, path.Root("effective"), types.ObjectUnknown(effectiveType.AttrTypes))
+			},
+			want: true,
+		},
+		{
+			name: "commit message and servers",
+			modify: func(plan *tfsdk.Plan) {
+				plan.SetAttribute(ctx, path.Root("commit_message"), types.StringValue("second message"))
+				plan.SetAttribute(ctx, path.Root("servers"), []string{"1.pool.ntp.org"})
+			},
+		},
+		{
+			name: "time zone",
+			modify: func(plan *tfsdk.Plan) {
+				plan.SetAttribute(ctx, path.Root("time_zone"), types.StringValue("Europe/Oslo"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+			tt.modify(&plan)
+
+			if got := onlyCommitMessageChanged(plan, state); got != tt.want {
+				t.Errorf("onlyCommitMessageChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -40,7 +40,7 @@ type connectivityWatchdogResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *connectivityWatchdogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *connectivityWatchdogResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "When enabled, will count failed connection attempts to the device hub and reboot the device if the threshold is reached.",
 		Attributes: map[string]schema.Attribute{
@@ -59,12 +59,20 @@ func (r *connectivityWatchdogResource) Schema(_ context.Context, _ resource.Sche
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"threshold": schema.Int64Attribute{
 				Required:    true,
 				Description: "defines how many consecutive failed pings are allowed before the watchdog triggers a reboot.",
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type connectivityWatchdogResourceModel struct {
@@ -83,6 +91,7 @@ func (m *connectivityWatchdogResourceModel) fromBundleData(bundleData config.Bun
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	threshold, err := strconv.ParseInt(data.Threshold, 10, 64)
 	if err != nil {
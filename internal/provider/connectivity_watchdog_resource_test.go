@@ -16,6 +16,7 @@ func TestAccConnectivityWatchdogResource(t *testing.T) {
 resource "qbee_connectivity_watchdog" "test" {
   tag = "terraform:acctest:connectivitywatchdog"
   extend = false
+  enabled = false
   threshold = 5
 }
 `,
@@ -23,6 +24,7 @@ resource "qbee_connectivity_watchdog" "test" {
 					resource.TestCheckResourceAttr("qbee_connectivity_watchdog.test", "tag", "terraform:acctest:connectivitywatchdog"),
 					resource.TestCheckNoResourceAttr("qbee_connectivity_watchdog.test", "node"),
 					resource.TestCheckResourceAttr("qbee_connectivity_watchdog.test", "extend", "false"),
+					resource.TestCheckResourceAttr("qbee_connectivity_watchdog.test", "enabled", "false"),
 					resource.TestCheckResourceAttr("qbee_connectivity_watchdog.test", "threshold", "5"),
 				),
 			},
@@ -33,6 +35,7 @@ resource "qbee_connectivity_watchdog" "test" {
 				ImportStateId:                        "tag:terraform:acctest:connectivitywatchdog",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 			// Update
 			{
@@ -47,6 +50,7 @@ resource "qbee_connectivity_watchdog" "test" {
 					resource.TestCheckNoResourceAttr("qbee_connectivity_watchdog.test", "tag"),
 					resource.TestCheckResourceAttr("qbee_connectivity_watchdog.test", "node", "integrationtests"),
 					resource.TestCheckResourceAttr("qbee_connectivity_watchdog.test", "extend", "true"),
+					resource.TestCheckResourceAttr("qbee_connectivity_watchdog.test", "enabled", "true"),
 					resource.TestCheckResourceAttr("qbee_connectivity_watchdog.test", "threshold", "3"),
 				),
 			},
@@ -57,6 +61,7 @@ resource "qbee_connectivity_watchdog" "test" {
 				ImportStateId:                        "node:integrationtests",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "node",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 		},
 	})
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultProfileName is the profile used when neither the profile attribute nor QBEE_PROFILE is set.
+const defaultProfileName = "default"
+
+// credentialsProfile holds the settings of a named profile in the credentials file.
+type credentialsProfile struct {
+	Username   string
+	Password   string
+	TOTPSecret string
+	APIToken   string
+	BaseURL    string
+}
+
+// hasCredentials returns true if the profile defines an API token or a username and password.
+func (p credentialsProfile) hasCredentials() bool {
+	return p.APIToken != "" || p.Username != "" || p.Password != "" || p.TOTPSecret != ""
+}
+
+// configSource names the provider config as the source of credentials in errors.
+const configSource = "the provider config"
+
+// hasLogin returns true if the profile defines any part of a username and password login.
+func (p credentialsProfile) hasLogin() bool {
+	return p.Username != "" || p.Password != "" || p.TOTPSecret != ""
+}
+
+// selectCredentials returns the credentials of the first source defining any: the provider config, then the
+// environment, then the profile. Credentials of different sources are never combined, except that a login in the
+// provider config is completed from the environment, for example to keep the password in QBEE_PASSWORD.
+// An API token and a login only conflict when they are set by the same source.
+// The base URL of the profile is returned along with its credentials, or alone if the profile has no credentials,
+// so that credentials from the provider config or environment are never sent to the instance of the profile.
+func selectCredentials(config, env credentialsProfile, profile *credentialsProfile) (credentialsProfile, error) {
+	var selected credentialsProfile
+	var source string
+
+	switch {
+	case config.hasCredentials():
+		selected, source = config, configSource
+	case env.hasCredentials():
+		selected, source = env, "the environment"
+	case profile != nil && profile.hasCredentials():
+		selected, source = *profile, "the profile"
+	default:
+		if profile != nil {
+			return credentialsProfile{BaseURL: profile.BaseURL}, nil
+		}
+
+		return credentialsProfile{}, nil
+	}
+
+	if selected.APIToken != "" && selected.hasLogin() {
+		return credentialsProfile{}, fmt.Errorf("both an API token and a username/password are set in %s", source)
+	}
+
+	if source == configSource && selected.APIToken == "" {
+		selected.Username = cmp.Or(selected.Username, env.Username)
+		selected.Password = cmp.Or(selected.Password, env.Password)
+		selected.TOTPSecret = cmp.Or(selected.TOTPSecret, env.TOTPSecret)
+	}
+
+	credentials := credentialsProfile{
+		Username:   selected.Username,
+		Password:   selected.Password,
+		TOTPSecret: selected.TOTPSecret,
+		APIToken:   selected.APIToken,
+	}
+
+	if profile != nil && (selected == *profile || !profile.hasCredentials()) {
+		credentials.BaseURL = profile.BaseURL
+	}
+
+	return credentials, nil
+}
+
+// defaultCredentialsFilePath returns the path of the credentials file, which is qbee/credentials in the
+// XDG config directory, by default ~/.config/qbee/credentials.
+func defaultCredentialsFilePath() (string, error) {
+	if configHome := os.Getenv("XDG_CONFIG_HOME"); configHome != "" {
+		return filepath.Join(configHome, "qbee", "credentials"), nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".config", "qbee", "credentials"), nil
+}
+
+// loadCredentialsProfile returns the profile with the given name from the credentials file.
+// When no profile name is given, the default profile is used if the file defines one, and nil is returned otherwise.
+// When a profile name is given, the file and the profile must exist.
+func loadCredentialsProfile(filePath, profileName string) (*credentialsProfile, error) {
+	optional := profileName == ""
+	if optional {
+		profileName = defaultProfileName
+	}
+
+	if filePath == "" {
+		var err error
+		if filePath, err = defaultCredentialsFilePath(); err != nil {
+			if optional {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("unable to determine the location of the credentials file: %w", err)
+		}
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		if optional && errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("unable to read credentials file: %w", err)
+	}
+	defer file.Close()
+
+	profiles, err := parseCredentialsFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse credentials file %s: %w", filePath, err)
+	}
+
+	profile, ok := profiles[profileName]
+	if !ok {
+		if optional {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("profile %q not found in credentials file %s", profileName, filePath)
+	}
+
+	return &profile, nil
+}
+
+// parseCredentialsFile parses an INI style credentials file with one section per profile:
+//
+//	[default]
+//	username = qbee@example.com
+//	password = secret
+//
+//	[production]
+//	api_token = token
+//	base_url = https://www.app.qbee.io
+//
+// Empty lines and lines starting with '#' or ';' are ignored.
+func parseCredentialsFile(r io.Reader) (map[string]credentialsProfile, error) {
+	profiles := make(map[string]credentialsProfile)
+
+	var profileName string
+	var profile *credentialsProfile
+
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			if profile != nil {
+				profiles[profileName] = *profile
+			}
+
+			profileName = strings.TrimSpace(line[1 : len(line)-1])
+			if profileName == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNumber)
+			}
+
+			if _, exists := profiles[profileName]; exists {
+				return nil, fmt.Errorf("line %d: duplicate profile %q", lineNumber, profileName)
+			}
+
+			profile = new(credentialsProfile)
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected 'key = value'", lineNumber)
+		}
+
+		if profile == nil {
+			return nil, fmt.Errorf("line %d: setting outside of a profile section", lineNumber)
+		}
+
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "username":
+			profile.Username = value
+		case "password":
+			profile.Password = value
+		case "totp_secret":
+			profile.TOTPSecret = value
+		case "api_token":
+			profile.APIToken = value
+		case "base_url":
+			profile.BaseURL = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown setting %q", lineNumber, key)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if profile != nil {
+		profiles[profileName] = *profile
+	}
+
+	return profiles, nil
+}
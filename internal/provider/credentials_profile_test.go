@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCredentialsFile = `
+# Personal account
+[default]
+username = qbee@example.com
+password = pass=word
+
+; Production account
+[production]
+api_token = token
+base_url  = https://qbee.example.com
+`
+
+func TestParseCredentialsFile(t *testing.T) {
+	profiles, err := parseCredentialsFile(strings.NewReader(testCredentialsFile))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]credentialsProfile{
+		"default":    {Username: "qbee@example.com", Password: "pass=word"},
+		"production": {APIToken: "token", BaseURL: "https://qbee.example.com"},
+	}
+
+	if len(profiles) != len(want) {
+		t.Fatalf("got %d profiles, want %d", len(profiles), len(want))
+	}
+
+	for name, profile := range want {
+		if profiles[name] != profile {
+			t.Errorf("profile %q: got %+v, want %+v", name, profiles[name], profile)
+		}
+	}
+}
+
+func TestParseCredentialsFileErrors(t *testing.T) {
+	tests := map[string]string{
+		"outside of section": "username = qbee",
+		"unknown setting":    "[default]\nusername = qbee\npasword = secret",
+		"missing value":      "[default]\nusername",
+		"empty profile name": "[ ]\nusername = qbee",
+		"duplicate profile":  "[default]\nusername = a\n[default]\nusername = b",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseCredentialsFile(strings.NewReader(content)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestLoadCredentialsProfile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(filePath, []byte(testCredentialsFile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	missingPath := filepath.Join(t.TempDir(), "missing")
+
+	tests := []struct {
+		name        string
+		filePath    string
+		profileName string
+		wantUser    string
+		wantNil     bool
+		wantErr     bool
+	}{
+		{name: "default profile", filePath: filePath, wantUser: "qbee@example.com"},
+		{name: "named profile", filePath: filePath, profileName: "production"},
+		{name: "unknown profile", filePath: filePath, profileName: "staging", wantErr: true},
+		{name: "missing file without profile", filePath: missingPath, wantNil: true},
+		{name: "missing file with profile", filePath: missingPath, profileName: "production", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, err := loadCredentialsProfile(tt.filePath, tt.profileName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if (profile == nil) != tt.wantNil {
+				t.Fatalf("got profile %+v, want nil %v", profile, tt.wantNil)
+			}
+
+			if profile != nil && profile.Username != tt.wantUser {
+				t.Errorf("got username %q, want %q", profile.Username, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestLoadCredentialsProfileWithoutDefault(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(filePath, []byte("[production]\napi_token = token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	profile, err := loadCredentialsProfile(filePath, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if profile != nil {
+		t.Errorf("got profile %+v, want nil", profile)
+	}
+}
+
+func TestSelectCredentials(t *testing.T) {
+	profile := &credentialsProfile{Username: "profile@example.com", Password: "profile-password"}
+
+	tests := []struct {
+		name    string
+		config  credentialsProfile
+		env     credentialsProfile
+		profile *credentialsProfile
+		want    credentialsProfile
+		wantErr bool
+	}{
+		{
+			name:    "config token wins over env password",
+			config:  credentialsProfile{APIToken: "token"},
+			env:     credentialsProfile{Username: "env@example.com", Password: "env-password"},
+			profile: profile,
+			want:    credentialsProfile{APIToken: "token"},
+		},
+		{
+			name:   "config login completed from env",
+			config: credentialsProfile{Username: "config@example.com"},
+			env:    credentialsProfile{Password: "env-password", APIToken: "env-token"},
+			want:   credentialsProfile{Username: "config@example.com", Password: "env-password"},
+		},
+		{
+			name:    "env token wins over profile login",
+			env:     credentialsProfile{APIToken: "env-token"},
+			profile: profile,
+			want:    credentialsProfile{APIToken: "env-token"},
+		},
+		{
+			name:    "profile used as a whole",
+			profile: profile,
+			want:    *profile,
+		},
+		{
+			name:    "profile base URL used with its credentials",
+			profile: &credentialsProfile{APIToken: "profile-token", BaseURL: "https://staging.example.com"},
+			want:    credentialsProfile{APIToken: "profile-token", BaseURL: "https://staging.example.com"},
+		},
+		{
+			name:    "profile base URL ignored with env credentials",
+			env:     credentialsProfile{APIToken: "env-token"},
+			profile: &credentialsProfile{APIToken: "profile-token", BaseURL: "https://staging.example.com"},
+			want:    credentialsProfile{APIToken: "env-token"},
+		},
+		{
+			name:    "profile with only a base URL",
+			env:     credentialsProfile{APIToken: "env-token"},
+			profile: &credentialsProfile{BaseURL: "https://staging.example.com"},
+			want:    credentialsProfile{APIToken: "env-token", BaseURL: "https://staging.example.com"},
+		},
+		{
+			name:    "token and login in config",
+			config:  credentialsProfile{APIToken: "token", Password: "password"},
+			wantErr: true,
+		},
+		{
+			name:    "token and login in env",
+			env:     credentialsProfile{APIToken: "token", Username: "env@example.com"},
+			wantErr: true,
+		},
+		{
+			name: "no credentials",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectCredentials(tt.config, tt.env, tt.profile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectCredentials() error = %v, want error %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("selectCredentials() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
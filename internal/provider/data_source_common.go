@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// dataSourceBase is a base struct that provides common functionality for all data sources in the provider.
+type dataSourceBase struct {
+	// name is the name of the data source, e.g. "devices". It is used for logging and error messages.
+	name string
+
+	// client is the provider configured client that can be used to interact with the Qbee API.
+	client *Client
+}
+
+// newDataSourceBase is a helper function to create a new dataSourceBase with the given name.
+func newDataSourceBase(name string) dataSourceBase {
+	return dataSourceBase{
+		name: name,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceBase) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceBase) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, d.name)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"go.qbee.io/client"
+)
+
+const (
+	pendingDevicesPath = "/api/v2/pendinghosts"
+	approveDevicePath  = "/api/v2/approvehost"
+	rejectDevicePath   = "/api/v2/rejecthost"
+	removeDevicePath   = "/api/v2/removeapprovedhost/"
+)
+
+// pendingDevice is a device that bootstrapped with a bootstrap key without auto accept,
+// and waits to be approved or rejected.
+type pendingDevice struct {
+	NodeID       string `json:"node_id"`
+	PubKeyDigest string `json:"pub_key_digest"`
+	Host         string `json:"host"`
+}
+
+type pendingDevicesResponse struct {
+	Items []pendingDevice `json:"items"`
+}
+
+type pendingDeviceRequest struct {
+	NodeID string `json:"node_id"`
+}
+
+// listPendingDevices returns the devices waiting to be approved.
+func (cli *Client) listPendingDevices(ctx context.Context) ([]pendingDevice, error) {
+	response := new(pendingDevicesResponse)
+
+	if err := cli.Call(ctx, http.MethodGet, pendingDevicesPath, nil, response); err != nil {
+		return nil, err
+	}
+
+	return response.Items, nil
+}
+
+// approveDevice approves the pending device with the given node ID.
+// It fails with errReadOnly if the client is read-only.
+func (cli *Client) approveDevice(ctx context.Context, nodeID string) error {
+	if cli.readOnly {
+		return errReadOnly
+	}
+
+	return cli.limiter.run(ctx, []string{"node:" + nodeID}, func() error {
+		return cli.Call(ctx, http.MethodPost, approveDevicePath, pendingDeviceRequest{NodeID: nodeID}, nil)
+	})
+}
+
+// rejectDevice rejects the pending device with the given node ID.
+// It fails with errReadOnly if the client is read-only.
+func (cli *Client) rejectDevice(ctx context.Context, nodeID string) error {
+	if cli.readOnly {
+		return errReadOnly
+	}
+
+	return cli.limiter.run(ctx, []string{"node:" + nodeID}, func() error {
+		return cli.Call(ctx, http.MethodPost, rejectDevicePath, pendingDeviceRequest{NodeID: nodeID}, nil)
+	})
+}
+
+// removeDevice removes the approved device with the given node ID, which has to bootstrap again to reconnect.
+// It fails with errReadOnly if the client is read-only.
+func (cli *Client) removeDevice(ctx context.Context, nodeID string) error {
+	if cli.readOnly {
+		return errReadOnly
+	}
+
+	return cli.limiter.run(ctx, []string{"node:" + nodeID}, func() error {
+		return cli.Call(ctx, http.MethodDelete, removeDevicePath+url.PathEscape(nodeID), nil, nil)
+	})
+}
+
+// isNotFoundError returns true if the error is an API error with status 404.
+func isNotFoundError(err error) bool {
+	clientErr, ok := err.(client.Error)
+	if !ok {
+		return false
+	}
+
+	errObj, ok := clientErr["error"].(map[string]any)
+	if !ok {
+		return false
+	}
+
+	code, ok := errObj["code"].(float64)
+
+	return ok && int(code) == http.StatusNotFound
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+const deviceAttributesPath = "/api/v2/deviceattribute/"
+
+// deviceAttributes are the user-defined attributes of a device, like its display name and location.
+type deviceAttributes struct {
+	DeviceName  string `json:"device_name"`
+	Description string `json:"description"`
+	Country     string `json:"country"`
+	City        string `json:"city"`
+	Zip         string `json:"zip"`
+	Address     string `json:"address"`
+	Latitude    string `json:"latitude"`
+	Longitude   string `json:"longitude"`
+}
+
+// getDeviceAttributes returns the attributes of the device with the given node ID.
+func (cli *Client) getDeviceAttributes(ctx context.Context, nodeID string) (*deviceAttributes, error) {
+	attributes := new(deviceAttributes)
+
+	if err := cli.Call(ctx, http.MethodGet, deviceAttributesPath+url.PathEscape(nodeID), nil, attributes); err != nil {
+		return nil, err
+	}
+
+	return attributes, nil
+}
+
+// setDeviceAttributes replaces the attributes of the device with the given node ID.
+// Changes to the same device are serialised with the configuration changes of the device.
+// It fails with errReadOnly if the client is read-only.
+func (cli *Client) setDeviceAttributes(ctx context.Context, nodeID string, attributes deviceAttributes) error {
+	if cli.readOnly {
+		return errReadOnly
+	}
+
+	return cli.limiter.run(ctx, []string{"node:" + nodeID}, func() error {
+		return cli.Call(ctx, http.MethodPut, deviceAttributesPath+url.PathEscape(nodeID), attributes, nil)
+	})
+}
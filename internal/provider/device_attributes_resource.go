@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &deviceAttributesResource{}
+	_ resource.ResourceWithConfigure        = &deviceAttributesResource{}
+	_ resource.ResourceWithConfigValidators = &deviceAttributesResource{}
+	_ resource.ResourceWithImportState      = &deviceAttributesResource{}
+)
+
+const (
+	errorWritingDeviceAttributes  = "error writing device_attributes resource"
+	errorReadingDeviceAttributes  = "error reading device_attributes resource"
+	errorDeletingDeviceAttributes = "error deleting device_attributes resource"
+)
+
+// NewDeviceAttributesResource is a helper function to simplify the provider implementation.
+func NewDeviceAttributesResource() resource.Resource {
+	return &deviceAttributesResource{
+		resourceBase: newResourceBase("device_attributes"),
+	}
+}
+
+type deviceAttributesResource struct {
+	resourceBase
+}
+
+type deviceAttributesResourceModel struct {
+	NodeID      types.String   `tfsdk:"node_id"`
+	DeviceName  types.String   `tfsdk:"device_name"`
+	Description types.String   `tfsdk:"description"`
+	Country     types.String   `tfsdk:"country"`
+	City        types.String   `tfsdk:"city"`
+	Zip         types.String   `tfsdk:"zip"`
+	Address     types.String   `tfsdk:"address"`
+	Latitude    types.Float64  `tfsdk:"latitude"`
+	Longitude   types.Float64  `tfsdk:"longitude"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceAttributesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Device attributes sets the display name, description and location of a device. " +
+			"Attributes that are not set are cleared on the device.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The node ID of the device.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"device_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The display name of the device.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description of the device.",
+			},
+			"country": schema.StringAttribute{
+				Optional:    true,
+				Description: "The country where the device is located.",
+			},
+			"city": schema.StringAttribute{
+				Optional:    true,
+				Description: "The city where the device is located.",
+			},
+			"zip": schema.StringAttribute{
+				Optional:    true,
+				Description: "The postal code of the address where the device is located.",
+			},
+			"address": schema.StringAttribute{
+				Optional:    true,
+				Description: "The street address where the device is located.",
+			},
+			"latitude": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The latitude of the location of the device, in decimal degrees.",
+				Validators:  []validator.Float64{float64validator.Between(-90, 90)},
+			},
+			"longitude": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The longitude of the location of the device, in decimal degrees.",
+				Validators:  []validator.Float64{float64validator.Between(-180, 180)},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *deviceAttributesResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("latitude"),
+			path.MatchRoot("longitude"),
+		),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deviceAttributesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.checkReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
+	var plan deviceAttributesResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.defaultTimeouts.Create)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	nodeID := plan.NodeID.ValueString()
+	tflog.Info(ctx, fmt.Sprintf("Setting device attributes of %v", nodeID))
+
+	if err := r.client.setDeviceAttributes(ctx, nodeID, plan.toDeviceAttributes()); err != nil {
+		resp.Diagnostics.AddError(errorWritingDeviceAttributes,
+			"error setting the device attributes: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceAttributesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state deviceAttributesResourceModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	nodeID := state.NodeID.ValueString()
+
+	attributes, err := r.client.getDeviceAttributes(ctx, nodeID)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The device was removed from qbee outside of Terraform
+			tflog.Info(ctx, fmt.Sprintf("Device %v not found, removing from state", nodeID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(errorReadingDeviceAttributes,
+			"error reading the device attributes: "+err.Error())
+		return
+	}
+
+	if err := state.fromDeviceAttributes(attributes); err != nil {
+		resp.Diagnostics.AddError(errorReadingDeviceAttributes, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deviceAttributesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
+	var plan deviceAttributesResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.defaultTimeouts.Update)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	nodeID := plan.NodeID.ValueString()
+	tflog.Info(ctx, fmt.Sprintf("Updating device attributes of %v", nodeID))
+
+	if err := r.client.setDeviceAttributes(ctx, nodeID, plan.toDeviceAttributes()); err != nil {
+		resp.Diagnostics.AddError(errorWritingDeviceAttributes,
+			"error setting the device attributes: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete clears the attributes of the device and removes the Terraform state on success.
+func (r *deviceAttributesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &resp.Diagnostics) {
+		return
+	}
+
+	var state deviceAttributesResourceModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.defaultTimeouts.Delete)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	nodeID := state.NodeID.ValueString()
+	tflog.Info(ctx, fmt.Sprintf("Clearing device attributes of %v", nodeID))
+
+	// The attributes of a device removed from qbee are gone with it
+	if err := r.client.setDeviceAttributes(ctx, nodeID, deviceAttributes{}); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(errorDeletingDeviceAttributes,
+			"error clearing the device attributes: "+err.Error())
+	}
+}
+
+// ImportState imports the resource state by the node ID of the device.
+func (r *deviceAttributesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("node_id"), req, resp)
+}
+
+// toDeviceAttributes converts the model to the device attributes of the API. Unset attributes are empty.
+func (m deviceAttributesResourceModel) toDeviceAttributes() deviceAttributes {
+	attributes := deviceAttributes{
+		DeviceName:  m.DeviceName.ValueString(),
+		Description: m.Description.ValueString(),
+		Country:     m.Country.ValueString(),
+		City:        m.City.ValueString(),
+		Zip:         m.Zip.ValueString(),
+		Address:     m.Address.ValueString(),
+	}
+
+	if !m.Latitude.IsNull() {
+		attributes.Latitude = strconv.FormatFloat(m.Latitude.ValueFloat64(), 'f', -1, 64)
+	}
+
+	if !m.Longitude.IsNull() {
+		attributes.Longitude = strconv.FormatFloat(m.Longitude.ValueFloat64(), 'f', -1, 64)
+	}
+
+	return attributes
+}
+
+// fromDeviceAttributes sets the attributes of the model from the device attributes of the API.
+func (m *deviceAttributesResourceModel) fromDeviceAttributes(attributes *deviceAttributes) error {
+	m.DeviceName = nullableStringValue(attributes.DeviceName)
+	m.Description = nullableStringValue(attributes.Description)
+	m.Country = nullableStringValue(attributes.Country)
+	m.City = nullableStringValue(attributes.City)
+	m.Zip = nullableStringValue(attributes.Zip)
+	m.Address = nullableStringValue(attributes.Address)
+
+	var err error
+	if m.Latitude, err = nullableFloat64Value(attributes.Latitude); err != nil {
+		return fmt.Errorf("invalid latitude %q: %w", attributes.Latitude, err)
+	}
+
+	if m.Longitude, err = nullableFloat64Value(attributes.Longitude); err != nil {
+		return fmt.Errorf("invalid longitude %q: %w", attributes.Longitude, err)
+	}
+
+	return nil
+}
+
+// nullableFloat64Value parses a decimal number, returning a null value for an empty string.
+func nullableFloat64Value(value string) (types.Float64, error) {
+	if value == "" {
+		return types.Float64Null(), nil
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return types.Float64Null(), err
+	}
+
+	return types.Float64Value(number), nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceAttributesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "qbee_device_attributes" "test" {
+  node_id = "integrationtests"
+  device_name = "Integration tests"
+  latitude = 59.9139
+  longitude = 10.7522
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_device_attributes.test", "node_id", "integrationtests"),
+					resource.TestCheckResourceAttr("qbee_device_attributes.test", "device_name", "Integration tests"),
+					resource.TestCheckNoResourceAttr("qbee_device_attributes.test", "address"),
+					resource.TestCheckResourceAttr("qbee_device_attributes.test", "latitude", "59.9139"),
+					resource.TestCheckResourceAttr("qbee_device_attributes.test", "longitude", "10.7522"),
+				),
+			},
+			// Update with an address and without coordinates
+			{
+				Config: providerConfig + `
+resource "qbee_device_attributes" "test" {
+  node_id = "integrationtests"
+  device_name = "Integration tests"
+  description = "Device used by the acceptance tests"
+  address = "Karl Johans gate 1"
+  zip = "0154"
+  city = "Oslo"
+  country = "Norway"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_device_attributes.test", "description", "Device used by the acceptance tests"),
+					resource.TestCheckResourceAttr("qbee_device_attributes.test", "address", "Karl Johans gate 1"),
+					resource.TestCheckResourceAttr("qbee_device_attributes.test", "zip", "0154"),
+					resource.TestCheckResourceAttr("qbee_device_attributes.test", "city", "Oslo"),
+					resource.TestCheckResourceAttr("qbee_device_attributes.test", "country", "Norway"),
+					resource.TestCheckNoResourceAttr("qbee_device_attributes.test", "latitude"),
+					resource.TestCheckNoResourceAttr("qbee_device_attributes.test", "longitude"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:                         "qbee_device_attributes.test",
+				ImportState:                          true,
+				ImportStateId:                        "integrationtests",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "node_id",
+			},
+		},
+	})
+}
+
+func TestDeviceAttributesResourceModel(t *testing.T) {
+	model := deviceAttributesResourceModel{
+		DeviceName: types.StringValue("gateway"),
+		Latitude:   types.Float64Value(59.9139),
+		Longitude:  types.Float64Value(-0.5),
+	}
+
+	attributes := model.toDeviceAttributes()
+	if attributes.Latitude != "59.9139" || attributes.Longitude != "-0.5" || attributes.City != "" {
+		t.Errorf("toDeviceAttributes() = %+v", attributes)
+	}
+
+	var read deviceAttributesResourceModel
+	if err := read.fromDeviceAttributes(&attributes); err != nil {
+		t.Fatalf("fromDeviceAttributes() error = %v", err)
+	}
+
+	if !read.DeviceName.Equal(model.DeviceName) || !read.Latitude.Equal(model.Latitude) ||
+		!read.Longitude.Equal(model.Longitude) || !read.City.IsNull() {
+		t.Errorf("fromDeviceAttributes() = %+v, want %+v", read, model)
+	}
+
+	attributes.Latitude = "north"
+	if err := read.fromDeviceAttributes(&attributes); err == nil {
+		t.Error("fromDeviceAttributes() error = nil, want an error for an invalid latitude")
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &deviceDataSource{}
+	_ datasource.DataSourceWithConfigure = &deviceDataSource{}
+)
+
+const errorReadingDevices = "error reading devices"
+
+// NewDeviceDataSource is a helper function to simplify the provider implementation.
+func NewDeviceDataSource() datasource.DataSource {
+	return &deviceDataSource{
+		dataSourceBase: newDataSourceBase("device"),
+	}
+}
+
+type deviceDataSource struct {
+	dataSourceBase
+}
+
+// deviceModel describes a device, as returned by the qbee_device and qbee_devices data sources.
+type deviceModel struct {
+	NodeID       types.String `tfsdk:"node_id"`
+	Title        types.String `tfsdk:"title"`
+	Tags         []string     `tfsdk:"tags"`
+	GroupID      types.String `tfsdk:"group_id"`
+	OS           types.String `tfsdk:"os"`
+	AgentVersion types.String `tfsdk:"agent_version"`
+	IPAddresses  []string     `tfsdk:"ip_addresses"`
+	LastSeen     types.Int64  `tfsdk:"last_seen"`
+	Online       types.Bool   `tfsdk:"online"`
+}
+
+type deviceDataSourceModel struct {
+	deviceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// deviceModelAttributes returns the schema attributes describing a device. The node ID is configured by the
+// qbee_device data source, and computed in the list of the qbee_devices data source.
+func deviceModelAttributes(nodeIDRequired bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"node_id": schema.StringAttribute{
+			Required:    nodeIDRequired,
+			Computed:    !nodeIDRequired,
+			Description: "The node ID of the device.",
+		},
+		"title": schema.StringAttribute{
+			Computed:    true,
+			Description: "The title (display name) of the device.",
+		},
+		"tags": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The tags of the device.",
+		},
+		"group_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the group the device is placed in.",
+		},
+		"os": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name and version of the operating system of the device.",
+		},
+		"agent_version": schema.StringAttribute{
+			Computed:    true,
+			Description: "The version of the qbee agent running on the device.",
+		},
+		"ip_addresses": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The IP addresses of the device.",
+		},
+		"last_seen": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The last time the device reported to qbee, as a Unix timestamp.",
+		},
+		"online": schema.BoolAttribute{
+			Computed:    true,
+			Description: "If the device is currently online.",
+		},
+	}
+}
+
+// newDeviceModel converts the inventory of a device to its model.
+func newDeviceModel(d device) deviceModel {
+	return deviceModel{
+		NodeID:       types.StringValue(d.NodeID),
+		Title:        types.StringValue(d.Title),
+		Tags:         d.Tags,
+		GroupID:      nullableStringValue(d.groupID()),
+		OS:           nullableStringValue(d.System.OS),
+		AgentVersion: nullableStringValue(d.AgentVersion),
+		IPAddresses:  d.System.IPAddresses,
+		LastSeen:     types.Int64Value(d.LastReported),
+		Online:       types.BoolValue(d.Status == deviceStatusOnline),
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *deviceDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := deviceModelAttributes(true)
+	attributes["timeouts"] = timeouts.Attributes(ctx)
+
+	resp.Schema = schema.Schema{
+		Description: "Device reads the inventory of a single device.",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *deviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config deviceDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	device, err := d.client.getDevice(ctx, config.NodeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadingDevices,
+			"error reading device "+config.NodeID.ValueString()+": "+err.Error())
+		return
+	}
+
+	state := deviceDataSourceModel{
+		deviceModel: newDeviceModel(*device),
+		Timeouts:    config.Timeouts,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
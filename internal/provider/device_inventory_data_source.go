@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &deviceInventoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &deviceInventoryDataSource{}
+)
+
+// NewDeviceInventoryDataSource is a helper function to simplify the provider implementation.
+func NewDeviceInventoryDataSource() datasource.DataSource {
+	return &deviceInventoryDataSource{
+		dataSourceBase: newDataSourceBase("device_inventory"),
+	}
+}
+
+type deviceInventoryDataSource struct {
+	dataSourceBase
+}
+
+type deviceInventoryDataSourceModel struct {
+	NodeID        types.String   `tfsdk:"node_id"`
+	Hostname      types.String   `tfsdk:"hostname"`
+	Architecture  types.String   `tfsdk:"architecture"`
+	CPUs          types.Int64    `tfsdk:"cpus"`
+	CPUHardware   types.String   `tfsdk:"cpu_hardware"`
+	MemoryTotal   types.Int64    `tfsdk:"memory_total"`
+	DiskTotal     types.Int64    `tfsdk:"disk_total"`
+	DiskFree      types.Int64    `tfsdk:"disk_free"`
+	OSType        types.String   `tfsdk:"os_type"`
+	Distribution  types.String   `tfsdk:"distribution"`
+	OS            types.String   `tfsdk:"os"`
+	KernelRelease types.String   `tfsdk:"kernel_release"`
+	KernelVersion types.String   `tfsdk:"kernel_version"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Schema defines the schema for the data source.
+func (d *deviceInventoryDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Device inventory reads the hardware and operating system inventory collected by the agent of " +
+			"a device. Attributes the agent has not reported are null.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The node ID of the device.",
+			},
+			"hostname": schema.StringAttribute{
+				Computed:    true,
+				Description: "The fully qualified hostname of the device.",
+			},
+			"architecture": schema.StringAttribute{
+				Computed:    true,
+				Description: "The CPU architecture of the device, for example x86_64 or aarch64.",
+			},
+			"cpus": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of CPUs of the device.",
+			},
+			"cpu_hardware": schema.StringAttribute{
+				Computed:    true,
+				Description: "The CPU hardware of the device, for example BCM2835.",
+			},
+			"memory_total": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total memory of the device, in bytes.",
+			},
+			"disk_total": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The size of the root filesystem of the device, in bytes.",
+			},
+			"disk_free": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The free space on the root filesystem of the device, in bytes.",
+			},
+			"os_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the operating system of the device, for example linux.",
+			},
+			"distribution": schema.StringAttribute{
+				Computed:    true,
+				Description: "The distribution of the operating system of the device, for example debian or ubuntu.",
+			},
+			"os": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name and version of the operating system of the device.",
+			},
+			"kernel_release": schema.StringAttribute{
+				Computed:    true,
+				Description: "The release of the kernel of the device, for example 6.1.0-18-amd64.",
+			},
+			"kernel_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of the kernel of the device.",
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *deviceInventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deviceInventoryDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, d.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	inventory, err := d.client.getSystemInventory(ctx, state.NodeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadingDevices,
+			"error reading the system inventory of device "+state.NodeID.ValueString()+": "+err.Error())
+		return
+	}
+
+	if err := state.fromSystemInventory(inventory.System); err != nil {
+		resp.Diagnostics.AddError(errorReadingDevices,
+			"error parsing the system inventory of device "+state.NodeID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// fromSystemInventory updates the model from the system inventory of the device.
+func (m *deviceInventoryDataSourceModel) fromSystemInventory(system systemInventoryInfo) error {
+	cpus, err := nullableInt64Value(system.CPUs)
+	if err != nil {
+		return fmt.Errorf("invalid number of CPUs %q: %w", system.CPUs, err)
+	}
+
+	m.Hostname = nullableStringValue(system.Hostname)
+	m.Architecture = nullableStringValue(system.Architecture)
+	m.CPUs = cpus
+	m.CPUHardware = nullableStringValue(system.CPUHardware)
+	m.MemoryTotal = types.Int64PointerValue(system.MemoryTotal)
+	m.DiskTotal = types.Int64PointerValue(system.DiskTotal)
+	m.DiskFree = types.Int64PointerValue(system.DiskFree)
+	m.OSType = nullableStringValue(system.OSType)
+	m.Distribution = nullableStringValue(system.Flavor)
+	m.OS = nullableStringValue(system.OS)
+	m.KernelRelease = nullableStringValue(system.KernelRelease)
+	m.KernelVersion = nullableStringValue(system.KernelVersion)
+
+	return nil
+}
+
+// nullableInt64Value parses an integer, returning a null value for an empty string.
+func nullableInt64Value(value string) (types.Int64, error) {
+	if value == "" {
+		return types.Int64Null(), nil
+	}
+
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return types.Int64Null(), err
+	}
+
+	return types.Int64Value(number), nil
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceInventoryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "qbee_device_inventory" "integrationtests" {
+  node_id = "integrationtests"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.qbee_device_inventory.integrationtests", "node_id", "integrationtests"),
+					resource.TestCheckResourceAttrSet("data.qbee_device_inventory.integrationtests", "architecture"),
+					resource.TestCheckResourceAttrSet("data.qbee_device_inventory.integrationtests", "kernel_release"),
+				),
+			},
+		},
+	})
+}
+
+func TestDeviceInventoryFromSystemInventory(t *testing.T) {
+	var model deviceInventoryDataSourceModel
+
+	err := model.fromSystemInventory(systemInventoryInfo{
+		Hostname:      "gateway-1.example.com",
+		Architecture:  "aarch64",
+		CPUs:          "4",
+		MemoryTotal:   new(int64(4 << 30)),
+		DiskFree:      new(int64(0)),
+		OSType:        "linux",
+		Flavor:        "debian",
+		KernelRelease: "6.1.0-rpi7-rpi-v8",
+	})
+	if err != nil {
+		t.Fatalf("fromSystemInventory() error = %v", err)
+	}
+
+	want := deviceInventoryDataSourceModel{
+		Hostname:      types.StringValue("gateway-1.example.com"),
+		Architecture:  types.StringValue("aarch64"),
+		CPUs:          types.Int64Value(4),
+		CPUHardware:   types.StringNull(),
+		MemoryTotal:   types.Int64Value(4 << 30),
+		DiskTotal:     types.Int64Null(),
+		DiskFree:      types.Int64Value(0),
+		OSType:        types.StringValue("linux"),
+		Distribution:  types.StringValue("debian"),
+		OS:            types.StringNull(),
+		KernelRelease: types.StringValue("6.1.0-rpi7-rpi-v8"),
+		KernelVersion: types.StringNull(),
+	}
+
+	if !reflect.DeepEqual(model, want) {
+		t.Errorf("fromSystemInventory() = %+v, want %+v", model, want)
+	}
+
+	if err := model.fromSystemInventory(systemInventoryInfo{CPUs: "four"}); err == nil {
+		t.Error("fromSystemInventory() with an invalid number of CPUs succeeded, want an error")
+	}
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	devicesPath = "/api/v2/inventory"
+
+	// systemInventoryPath is the path of the system inventory of a device, followed by its node ID.
+	systemInventoryPath = "/api/v2/inventory/system/"
+
+	// devicesPageSize is the number of devices requested per page when listing devices.
+	devicesPageSize = 1000
+
+	// deviceStatusOnline is the status of devices that are currently connected to qbee.
+	deviceStatusOnline = "online"
+)
+
+// device is the inventory of a device known to qbee.
+type device struct {
+	NodeID       string       `json:"node_id"`
+	PubKeyDigest string       `json:"pub_key_digest"`
+	Title        string       `json:"title"`
+	Tags         []string     `json:"tags"`
+	Ancestors    []string     `json:"ancestors"`
+	Status       string       `json:"status"`
+	AgentVersion string       `json:"agent_version"`
+	LastReported int64        `json:"last_reported"`
+	System       deviceSystem `json:"system"`
+}
+
+// deviceSystem is the system information reported by the agent of a device.
+type deviceSystem struct {
+	OS          string   `json:"os_pretty_name"`
+	IPAddresses []string `json:"ip_addresses"`
+}
+
+// systemInventory is the system inventory collected by the agent of a device.
+type systemInventory struct {
+	System systemInventoryInfo `json:"system"`
+}
+
+// systemInventoryInfo is the hardware and operating system information of a device.
+// The agent reports the number of CPUs as a string, and sizes in bytes. Sizes are pointers, as a size of 0,
+// like the free space of a full disk, differs from a size the agent has not reported.
+type systemInventoryInfo struct {
+	Hostname      string `json:"fqhost"`
+	Architecture  string `json:"arch"`
+	CPUs          string `json:"cpus"`
+	CPUHardware   string `json:"cpu_hardware"`
+	MemoryTotal   *int64 `json:"mem_total"`
+	DiskTotal     *int64 `json:"disk_total"`
+	DiskFree      *int64 `json:"disk_free"`
+	OSType        string `json:"ostype"`
+	Flavor        string `json:"flavor"`
+	OS            string `json:"os_pretty_name"`
+	KernelRelease string `json:"release"`
+	KernelVersion string `json:"version"`
+}
+
+type devicesResponse struct {
+	Items []device `json:"items"`
+}
+
+// groupID returns the ID of the group the device is placed in, or an empty string if not known.
+func (d device) groupID() string {
+	// The ancestors of a node end with the node itself
+	if len(d.Ancestors) < 2 {
+		return ""
+	}
+
+	return d.Ancestors[len(d.Ancestors)-2]
+}
+
+// deviceSearch filters the devices listed by the API. Empty fields don't filter.
+type deviceSearch struct {
+	Tag    string `json:"tags,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// listDevices returns the inventory of the devices matching the search, reading pages until a short page.
+// The total returned by the API is not used, as it may be missing.
+func (cli *Client) listDevices(ctx context.Context, search deviceSearch) ([]device, error) {
+	var devices []device
+
+	for offset := 0; ; offset += devicesPageSize {
+		response := new(devicesResponse)
+		if err := cli.Call(ctx, http.MethodGet, devicesPath+"?"+devicesQuery(search, offset).Encode(), nil, response); err != nil {
+			return nil, err
+		}
+
+		devices = append(devices, response.Items...)
+
+		if len(response.Items) < devicesPageSize {
+			return devices, nil
+		}
+	}
+}
+
+// devicesQuery returns the query listing the page of devices matching the search at the given offset.
+func devicesQuery(search deviceSearch, offset int) url.Values {
+	query := url.Values{
+		"limit":  {strconv.Itoa(devicesPageSize)},
+		"offset": {strconv.Itoa(offset)},
+	}
+
+	if search != (deviceSearch{}) {
+		// The search only has string fields, so it can always be encoded
+		searchJSON, _ := json.Marshal(search)
+		query.Set("search", string(searchJSON))
+	}
+
+	return query
+}
+
+// getDevice returns the inventory of the device with the given node ID.
+func (cli *Client) getDevice(ctx context.Context, nodeID string) (*device, error) {
+	response := new(device)
+
+	if err := cli.Call(ctx, http.MethodGet, devicesPath+"/"+url.PathEscape(nodeID), nil, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// getSystemInventory returns the system inventory of the device with the given node ID.
+func (cli *Client) getSystemInventory(ctx context.Context, nodeID string) (*systemInventory, error) {
+	response := new(systemInventory)
+
+	if err := cli.Call(ctx, http.MethodGet, systemInventoryPath+url.PathEscape(nodeID), nil, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
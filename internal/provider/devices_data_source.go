@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &devicesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &devicesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &devicesDataSource{}
+)
+
+// NewDevicesDataSource is a helper function to simplify the provider implementation.
+func NewDevicesDataSource() datasource.DataSource {
+	return &devicesDataSource{
+		dataSourceBase: newDataSourceBase("devices"),
+	}
+}
+
+type devicesDataSource struct {
+	dataSourceBase
+}
+
+type devicesDataSourceModel struct {
+	Tag       types.String   `tfsdk:"tag"`
+	GroupID   types.String   `tfsdk:"group_id"`
+	NameRegex types.String   `tfsdk:"name_regex"`
+	Online    types.Bool     `tfsdk:"online"`
+	NodeIDs   []string       `tfsdk:"node_ids"`
+	Devices   []deviceModel  `tfsdk:"devices"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Schema defines the schema for the data source.
+func (d *devicesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Devices lists the inventory of the devices, optionally filtered. All filters must match.",
+		Attributes: map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the devices with this tag.",
+			},
+			"group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the devices in this group, or in any of its subgroups.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the devices with a title matching this regular expression.",
+			},
+			"online": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list the devices that are online (true) or offline (false).",
+			},
+			"node_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The node IDs of the matching devices.",
+			},
+			"devices": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching devices.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: deviceModelAttributes(false),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+// ValidateConfig checks that the name filter is a valid regular expression.
+func (d *devicesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+	if resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *devicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state devicesDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, d.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	devices, err := d.client.listDevices(ctx, state.search())
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadingDevices, "error listing the devices: "+err.Error())
+		return
+	}
+
+	filter, err := state.filter()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), errorReadingDevices, err.Error())
+		return
+	}
+
+	state.NodeIDs = []string{}
+	state.Devices = []deviceModel{}
+
+	for _, device := range devices {
+		if !filter(device) {
+			continue
+		}
+
+		state.NodeIDs = append(state.NodeIDs, device.NodeID)
+		state.Devices = append(state.Devices, newDeviceModel(device))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// search returns the filters of the model supported by the API. All filters are still applied to the
+// listed devices using filter.
+func (m devicesDataSourceModel) search() deviceSearch {
+	search := deviceSearch{Tag: m.Tag.ValueString()}
+
+	// Devices that are not online can have several statuses, so only online devices are searched for
+	if m.Online.ValueBool() {
+		search.Status = deviceStatusOnline
+	}
+
+	return search
+}
+
+// filter returns a function that reports if a device matches all filters of the model.
+func (m devicesDataSourceModel) filter() (func(device) bool, error) {
+	var nameRegex *regexp.Regexp
+	if !m.NameRegex.IsNull() {
+		var err error
+		if nameRegex, err = regexp.Compile(m.NameRegex.ValueString()); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(d device) bool {
+		if !m.Tag.IsNull() && !slices.Contains(d.Tags, m.Tag.ValueString()) {
+			return false
+		}
+
+		// Devices in subgroups have the group among their ancestors
+		if !m.GroupID.IsNull() && !slices.Contains(d.Ancestors, m.GroupID.ValueString()) {
+			return false
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(d.Title) {
+			return false
+		}
+
+		if !m.Online.IsNull() && m.Online.ValueBool() != (d.Status == deviceStatusOnline) {
+			return false
+		}
+
+		return true
+	}, nil
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevicesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "qbee_devices" "all" {}
+
+data "qbee_devices" "integrationtests" {
+  name_regex = "^integrationtests$"
+}
+
+data "qbee_device" "integrationtests" {
+  node_id = "integrationtests"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.qbee_devices.all", "devices.#"),
+					resource.TestCheckResourceAttr("data.qbee_device.integrationtests", "node_id", "integrationtests"),
+					resource.TestCheckResourceAttrSet("data.qbee_device.integrationtests", "title"),
+					resource.TestCheckResourceAttrSet("data.qbee_device.integrationtests", "last_seen"),
+				),
+			},
+		},
+	})
+}
+
+func TestDevicesDataSourceFilter(t *testing.T) {
+	devices := []device{
+		{NodeID: "gateway-1", Title: "gateway-oslo", Tags: []string{"gateway"}, Ancestors: []string{"root", "nordics", "gateway-1"}, Status: "online"},
+		{NodeID: "gateway-2", Title: "gateway-berlin", Tags: []string{"gateway"}, Ancestors: []string{"root", "germany", "gateway-2"}, Status: "offline"},
+		{NodeID: "sensor-1", Title: "sensor-oslo", Ancestors: []string{"root", "nordics", "norway", "sensor-1"}, Status: "online"},
+	}
+
+	tests := []struct {
+		name  string
+		model devicesDataSourceModel
+		want  []string
+	}{
+		{
+			name:  "no filter",
+			model: devicesDataSourceModel{},
+			want:  []string{"gateway-1", "gateway-2", "sensor-1"},
+		},
+		{
+			name:  "tag",
+			model: devicesDataSourceModel{Tag: types.StringValue("gateway")},
+			want:  []string{"gateway-1", "gateway-2"},
+		},
+		{
+			name:  "group includes subgroups",
+			model: devicesDataSourceModel{GroupID: types.StringValue("nordics")},
+			want:  []string{"gateway-1", "sensor-1"},
+		},
+		{
+			name:  "name",
+			model: devicesDataSourceModel{NameRegex: types.StringValue("-oslo$")},
+			want:  []string{"gateway-1", "sensor-1"},
+		},
+		{
+			name:  "offline",
+			model: devicesDataSourceModel{Online: types.BoolValue(false)},
+			want:  []string{"gateway-2"},
+		},
+		{
+			name:  "all filters must match",
+			model: devicesDataSourceModel{Tag: types.StringValue("gateway"), Online: types.BoolValue(true)},
+			want:  []string{"gateway-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := tt.model.filter()
+			if err != nil {
+				t.Fatalf("filter() error = %v", err)
+			}
+
+			var got []string
+			for _, d := range devices {
+				if filter(d) {
+					got = append(got, d.NodeID)
+				}
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("filter() matched %v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("filter() matched %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	if _, err := (devicesDataSourceModel{NameRegex: types.StringValue("(")}).filter(); err == nil {
+		t.Error("filter() error = nil, want an error for an invalid regular expression")
+	}
+}
+
+func TestDevicesQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		model      devicesDataSourceModel
+		wantSearch string
+	}{
+		{name: "no filter", model: devicesDataSourceModel{}},
+		{name: "name is filtered locally", model: devicesDataSourceModel{NameRegex: types.StringValue("oslo")}},
+		{name: "offline is filtered locally", model: devicesDataSourceModel{Online: types.BoolValue(false)}},
+		{
+			name:       "tag and online",
+			model:      devicesDataSourceModel{Tag: types.StringValue("gateway"), Online: types.BoolValue(true)},
+			wantSearch: `{"tags":"gateway","status":"online"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := devicesQuery(tt.model.search(), 2*devicesPageSize)
+
+			if got := query.Get("search"); got != tt.wantSearch {
+				t.Errorf("search = %q, want %q", got, tt.wantSearch)
+			}
+
+			if got, want := query.Get("offset"), strconv.Itoa(2*devicesPageSize); got != want {
+				t.Errorf("offset = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestNewDeviceModel(t *testing.T) {
+	model := newDeviceModel(device{
+		NodeID:    "gateway-1",
+		Title:     "gateway-oslo",
+		Ancestors: []string{"root", "nordics", "gateway-1"},
+		Status:    "online",
+	})
+
+	if model.GroupID.ValueString() != "nordics" || !model.Online.ValueBool() || !model.OS.IsNull() {
+		t.Errorf("newDeviceModel() = %+v", model)
+	}
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.qbee.io/client/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resourceModelManager                  = &dockerComposeResourceModel{}
+	_ resource.Resource                     = &dockerComposeResource{}
+	_ resource.ResourceWithConfigure        = &dockerComposeResource{}
+	_ resource.ResourceWithConfigValidators = &dockerComposeResource{}
+	_ resource.ResourceWithImportState      = &dockerComposeResource{}
+)
+
+// NewDockerComposeResource is a helper function to simplify the provider implementation.
+func NewDockerComposeResource() resource.Resource {
+	return &dockerComposeResource{
+		configurationResource: configurationResource{
+			resourceBase: newResourceBase(config.DockerComposeBundle),
+			modelFactory: func() any {
+				return new(dockerComposeResourceModel)
+			},
+		},
+	}
+}
+
+type dockerComposeResource struct {
+	configurationResource
+}
+
+// Schema defines the schema for the resource.
+func (r *dockerComposeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Controls docker compose projects running in the system.",
+		Attributes: map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The tag for which to set the configuration. Either tag or node is required.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"node": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The node for which to set the configuration. Either tag or node is required.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"extend": schema.BoolAttribute{
+				Required: true,
+				Description: "If the configuration should extend configuration from the parent nodes of the node " +
+					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
+			},
+			"enabled": enabledAttribute(),
+			"projects": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The list of compose projects to be running in the system.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the compose project",
+						},
+						"file": schema.StringAttribute{
+							Required:    true,
+							Description: "The compose file (from file manager) defining the project",
+						},
+						"context": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+							Description: "A tar archive (from file manager) with the build context of the project",
+						},
+						"use_context": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "If the build context should be used to build the images of the project",
+						},
+						"pre_condition": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+							Description: "A condition that must be met before the project is started",
+						},
+						"parameters": schema.ListNestedAttribute{
+							Optional:    true,
+							Description: "Define values to be used in the compose file, which is rendered as a template.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Description: "Key of the parameter used in the compose file.",
+										Required:    true,
+									},
+									"value": schema.StringAttribute{
+										Description: "Value of the parameter which will replace Key placeholders.",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"registry_auths": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Credentials for container registry authentication.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server": schema.StringAttribute{
+							Required:    true,
+							Description: "Hostname of the registry",
+						},
+						"username": schema.StringAttribute{
+							Required:    true,
+							Description: "Username for the registry",
+						},
+						"password": schema.StringAttribute{
+							Required:    true,
+							Description: "Password for the registry",
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"clean": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "If set to true, projects that are removed from the configuration are stopped and their " +
+					"containers, networks and volumes removed from the devices.",
+			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
+		},
+	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
+}
+
+type composeProjectResourceModel struct {
+	Name         types.String        `tfsdk:"name"`
+	File         types.String        `tfsdk:"file"`
+	Context      types.String        `tfsdk:"context"`
+	UseContext   types.Bool          `tfsdk:"use_context"`
+	PreCondition types.String        `tfsdk:"pre_condition"`
+	Parameters   []templateParameter `tfsdk:"parameters"`
+}
+
+type dockerComposeResourceModel struct {
+	configurationResourceModel
+	Projects      []composeProjectResourceModel `tfsdk:"projects"`
+	RegistryAuths []registryAuthResourceModel   `tfsdk:"registry_auths"`
+	Clean         types.Bool                    `tfsdk:"clean"`
+}
+
+func (m dockerComposeResourceModel) getConfigBundle() config.Bundle {
+	return config.DockerComposeBundle
+}
+
+func (m *dockerComposeResourceModel) fromBundleData(bundleData config.BundleData) error {
+	data := bundleData.DockerCompose
+	if data == nil {
+		return fmt.Errorf("docker_compose bundle data is nil")
+	}
+
+	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
+	m.Clean = types.BoolValue(data.Clean)
+
+	for _, project := range data.Projects {
+		var parameters []templateParameter
+		for _, p := range project.Parameters {
+			parameters = append(parameters, templateParameter{
+				Key:   types.StringValue(p.Key),
+				Value: types.StringValue(p.Value),
+			})
+		}
+
+		m.Projects = append(m.Projects, composeProjectResourceModel{
+			Name:         types.StringValue(project.Name),
+			File:         types.StringValue(project.File),
+			Context:      types.StringValue(project.Context),
+			UseContext:   types.BoolValue(project.UseContext),
+			PreCondition: types.StringValue(project.PreCondition),
+			Parameters:   parameters,
+		})
+	}
+
+	for _, registryAuth := range data.RegistryAuths {
+		m.RegistryAuths = append(m.RegistryAuths, registryAuthResourceModel{
+			Server:   types.StringValue(registryAuth.Server),
+			Username: types.StringValue(registryAuth.Username),
+			Password: types.StringValue(registryAuth.Password),
+		})
+	}
+
+	return nil
+}
+
+func (m dockerComposeResourceModel) toBundleData(metadata config.Metadata) any {
+	bundleData := config.DockerCompose{
+		Metadata: metadata,
+	}
+
+	if metadata.Reset {
+		return bundleData
+	}
+
+	bundleData.Clean = m.Clean.ValueBool()
+
+	for _, project := range m.Projects {
+		var parameters []config.TemplateParameter
+		for _, p := range project.Parameters {
+			parameters = append(parameters, config.TemplateParameter{
+				Key:   p.Key.ValueString(),
+				Value: p.Value.ValueString(),
+			})
+		}
+
+		bundleData.Projects = append(bundleData.Projects, config.Compose{
+			Name:         project.Name.ValueString(),
+			File:         project.File.ValueString(),
+			Context:      project.Context.ValueString(),
+			UseContext:   project.UseContext.ValueBool(),
+			PreCondition: project.PreCondition.ValueString(),
+			Parameters:   parameters,
+		})
+	}
+
+	for _, registryAuth := range m.RegistryAuths {
+		bundleData.RegistryAuths = append(bundleData.RegistryAuths, config.RegistryAuth{
+			Server:   registryAuth.Server.ValueString(),
+			Username: registryAuth.Username.ValueString(),
+			Password: registryAuth.Password.ValueString(),
+		})
+	}
+
+	return bundleData
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDockerComposeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "qbee_docker_compose" "test" {
+  tag = "terraform:acctest:dockercompose"
+  extend = true
+  projects = [
+    {
+      name = "project-a"
+      file = "/acctest/compose/compose.yml"
+    }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "tag", "terraform:acctest:dockercompose"),
+					resource.TestCheckNoResourceAttr("qbee_docker_compose.test", "node"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "extend", "true"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "clean", "false"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.#", "1"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.name", "project-a"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.file", "/acctest/compose/compose.yml"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.context", ""),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.use_context", "false"),
+					resource.TestCheckNoResourceAttr("qbee_docker_compose.test", "projects.0.parameters"),
+				),
+			},
+			// Update with all attributes
+			{
+				Config: providerConfig + `
+resource "qbee_docker_compose" "test" {
+  tag = "terraform:acctest:dockercompose"
+  extend = false
+  clean = true
+  projects = [
+    {
+      name = "project-b"
+      file = "/acctest/compose/compose.yml"
+      context = "/acctest/compose/context.tar.gz"
+      use_context = true
+      pre_condition = "true"
+      parameters = [
+        {
+          key = "version"
+          value = "1.2.3"
+        }
+      ]
+    }
+  ]
+  registry_auths = [
+    {
+      server = "registry.example.com"
+      username = "user"
+      password = "password"
+    }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "extend", "false"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "clean", "true"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.#", "1"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.name", "project-b"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.context", "/acctest/compose/context.tar.gz"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.use_context", "true"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.pre_condition", "true"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.parameters.#", "1"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.parameters.0.key", "version"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "projects.0.parameters.0.value", "1.2.3"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "registry_auths.#", "1"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "registry_auths.0.server", "registry.example.com"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "registry_auths.0.username", "user"),
+					resource.TestCheckResourceAttr("qbee_docker_compose.test", "registry_auths.0.password", "password"),
+				),
+			},
+			// Import tag
+			{
+				ResourceName:                         "qbee_docker_compose.test",
+				ImportState:                          true,
+				ImportStateId:                        "tag:terraform:acctest:dockercompose",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
+			},
+		},
+	})
+}
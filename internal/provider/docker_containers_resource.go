@@ -39,7 +39,7 @@ type dockerContainersResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *dockerContainersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *dockerContainersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Controls docker containers running in the system.",
 		Attributes: map[string]schema.Attribute{
@@ -58,6 +58,7 @@ func (r *dockerContainersResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"containers": schema.ListNestedAttribute{
 				Required:    true,
 				Description: "The list of containers to be running in the system.",
@@ -119,8 +120,15 @@ func (r *dockerContainersResource) Schema(_ context.Context, _ resource.SchemaRe
 					},
 				},
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type dockerContainerResourceModel struct {
@@ -145,6 +153,7 @@ func (m *dockerContainersResourceModel) fromBundleData(bundleData config.BundleD
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	for _, container := range data.Containers {
 		m.Containers = append(m.Containers, dockerContainerResourceModel{
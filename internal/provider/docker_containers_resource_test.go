@@ -82,6 +82,7 @@ resource "qbee_docker_containers" "test" {
 				ImportStateId:                        "tag:terraform:acctest:dockercontainer",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 			// Update to be for a node
 			{
@@ -113,6 +114,7 @@ resource "qbee_docker_containers" "test" {
 				ImportStateId:                        "node:integrationtests",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "node",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 		},
 	})
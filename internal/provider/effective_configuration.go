@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.qbee.io/client/config"
+)
+
+// nonBundleAttributes are the attributes of configuration resources that are not part of the bundle data,
+// and therefore not mirrored in the effective attribute.
+var nonBundleAttributes = []string{
+	"tag",
+	"node",
+	"extend",
+	"timeouts",
+	"commit_message",
+	"last_commit_sha",
+	"last_commit_created",
+	"read_effective",
+	"effective",
+}
+
+// effectiveAttribute returns the schema of the effective attribute of a configuration resource.
+// It mirrors the bundle attributes of the resource as computed attributes, leaving out write-only
+// and computed-only attributes, as well as the given excluded attributes.
+func effectiveAttribute(attributes map[string]schema.Attribute, excluded ...string) schema.Attribute {
+	effectiveAttributes := make(map[string]schema.Attribute)
+
+	for name, attribute := range attributes {
+		if slices.Contains(nonBundleAttributes, name) || slices.Contains(excluded, name) {
+			continue
+		}
+
+		if attribute.IsWriteOnly() || (attribute.IsComputed() && !attribute.IsOptional()) {
+			continue
+		}
+
+		effectiveAttributes[name] = computedAttribute(attribute)
+	}
+
+	return schema.SingleNestedAttribute{
+		Computed: true,
+		Description: "The effective configuration of the node or tag, merged with the configuration inherited " +
+			"from its parent nodes. This is the configuration that is applied to the devices, which differs from " +
+			"the configuration of the resource when extend is true. Only read when read_effective is true. Null " +
+			"otherwise, or if neither the node or tag nor any of its parents has this configuration.",
+		Attributes: effectiveAttributes,
+	}
+}
+
+// readEffectiveAttribute returns the schema of the read_effective attribute of a configuration resource.
+func readEffectiveAttribute() schema.Attribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Description: "Whether to read the effective attribute. This takes an additional request to the API on " +
+			"every refresh, so it is disabled by default. Changing it does not create a commit.",
+	}
+}
+
+// computedAttributes returns computed copies of the given attributes, see computedAttribute.
+func computedAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	computed := make(map[string]schema.Attribute, len(attributes))
+
+	for name, attribute := range attributes {
+		computed[name] = computedAttribute(attribute)
+	}
+
+	return computed
+}
+
+// computedAttribute returns a computed copy of the given attribute with the same type, description and
+// sensitivity. Defaults, validators and plan modifiers are left out, as they only apply to configured values.
+// The type must be kept as is, so that values can be copied from the attribute to its computed copy.
+func computedAttribute(attribute schema.Attribute) schema.Attribute {
+	switch attribute := attribute.(type) {
+	case schema.StringAttribute:
+		return schema.StringAttribute{
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case schema.BoolAttribute:
+		return schema.BoolAttribute{
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case schema.Int64Attribute:
+		return schema.Int64Attribute{
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case schema.Float64Attribute:
+		return schema.Float64Attribute{
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case schema.ListAttribute:
+		return schema.ListAttribute{
+			ElementType: attribute.ElementType,
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case schema.ListNestedAttribute:
+		return schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: computedAttributes(attribute.NestedObject.Attributes),
+			},
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	case schema.SingleNestedAttribute:
+		return schema.SingleNestedAttribute{
+			Attributes:  computedAttributes(attribute.Attributes),
+			CustomType:  attribute.CustomType,
+			Computed:    true,
+			Sensitive:   attribute.Sensitive,
+			Description: attribute.Description,
+		}
+	default:
+		panic(fmt.Sprintf("unsupported attribute type %T for the effective configuration", attribute))
+	}
+}
+
+// effectiveObjectType returns the object type of the effective attribute in the schema of the given state.
+func effectiveObjectType(ctx context.Context, state tfsdk.State) (types.ObjectType, diag.Diagnostics) {
+	attributeType, diags := state.Schema.TypeAtPath(ctx, path.Root("effective"))
+	if diags.HasError() {
+		return types.ObjectType{}, diags
+	}
+
+	return attributeType.(types.ObjectType), diags
+}
+
+// readEffective reads the effective configuration of the node or tag of the model, which is the active
+// configuration merged with the configuration of its parent nodes, and returns it as the value of the
+// effective attribute. The state is only used for its schema. The value is null unless read_effective is true.
+func (r *configurationResource) readEffective(
+	ctx context.Context,
+	state tfsdk.State,
+	model resourceModelManager,
+) (types.Object, diag.Diagnostics) {
+	effectiveType, diags := effectiveObjectType(ctx, state)
+	if diags.HasError() {
+		return types.ObjectNull(nil), diags
+	}
+
+	null := types.ObjectNull(effectiveType.AttrTypes)
+	base := model.getBaseResourceModel()
+
+	if !base.ReadEffective.ValueBool() {
+		return null, diags
+	}
+
+	activeConfig, err := r.client.GetActiveConfig(ctx, base.getEntityType(), base.getEntityID(), config.EntityConfigScopeAll)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading effective %s configuration", r.name),
+			err.Error(),
+		)
+		return null, diags
+	}
+
+	if !slices.Contains(activeConfig.Bundles, model.getConfigBundle()) {
+		return null, diags
+	}
+
+	effective := r.modelFactory().(resourceModelManager)
+	effective.setEntityID(activeConfig.Type, activeConfig.EntityID)
+	effective.setStateOnlyAttributes(base)
+	effective.setEffective(null)
+
+	if err := effective.fromBundleData(activeConfig.BundleData); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error parsing effective %s configuration", r.name),
+			err.Error(),
+		)
+		return null, diags
+	}
+
+	return effectiveValue(ctx, state, effective)
+}
+
+// effectiveValue converts the given resource model to a value of the effective attribute, using the schema
+// of the given state and keeping only the attributes that are mirrored in the effective attribute.
+func effectiveValue(ctx context.Context, state tfsdk.State, model any) (types.Object, diag.Diagnostics) {
+	effectiveType, diags := effectiveObjectType(ctx, state)
+	if diags.HasError() {
+		return types.ObjectNull(nil), diags
+	}
+
+	null := types.ObjectNull(effectiveType.AttrTypes)
+
+	converted := tfsdk.State{Schema: state.Schema}
+	if diags.Append(converted.Set(ctx, model)...); diags.HasError() {
+		return null, diags
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := converted.Raw.As(&attributes); err != nil {
+		diags.AddError("Error converting effective configuration", err.Error())
+		return null, diags
+	}
+
+	effectiveAttributes := make(map[string]tftypes.Value, len(effectiveType.AttrTypes))
+	for name := range effectiveType.AttrTypes {
+		effectiveAttributes[name] = attributes[name]
+	}
+
+	value, err := effectiveType.ValueFromTerraform(ctx, tftypes.NewValue(effectiveType.TerraformType(ctx), effectiveAttributes))
+	if err != nil {
+		diags.AddError("Error converting effective configuration", err.Error())
+		return null, diags
+	}
+
+	return value.(types.Object), diags
+}
+
+// warnOnError converts the errors of the given diagnostics to warnings. It is used after a configuration
+// change was committed, as failing to read the effective configuration must not fail the change.
+func warnOnError(diags diag.Diagnostics) diag.Diagnostics {
+	var warnings diag.Diagnostics
+
+	for _, d := range diags {
+		warnings.AddWarning(d.Summary(), d.Detail())
+	}
+
+	return warnings
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.qbee.io/client/config"
+)
+
+// configurationResourceSchema returns the schema of the given configuration resource.
+func configurationResourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() diagnostics = %v", resp.Diagnostics)
+	}
+
+	return resp.Schema
+}
+
+func TestEffectiveAttribute(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range []func() resource.Resource{
+		NewConfigurationBundleResource,
+		NewConnectivityWatchdogResource,
+		NewDockerComposeResource,
+		NewDockerContainersResource,
+		NewFiledistributionResource,
+		NewFirewallResource,
+		NewMetricsMonitorResource,
+		NewNTPResource,
+		NewPackageManagementResource,
+		NewParametersResource,
+		NewPasswordResource,
+		NewPodmanContainersResource,
+		NewProcessWatchResource,
+		NewProxyResource,
+		NewRaucResource,
+		NewSSHKeysResource,
+		NewSettingsResource,
+		NewSoftwareManagementResource,
+		NewUsersResource,
+	} {
+		r := newResource()
+		resourceSchema := configurationResourceSchema(t, r)
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "qbee"}, &metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			if diags := resourceSchema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("ValidateImplementation() diagnostics = %v", diags)
+			}
+
+			effective, ok := resourceSchema.Attributes["effective"].(schema.SingleNestedAttribute)
+			if !ok || !effective.Computed {
+				t.Fatalf("effective attribute = %#v, want a computed single nested attribute", resourceSchema.Attributes["effective"])
+			}
+
+			if readEffective, ok := resourceSchema.Attributes["read_effective"].(schema.BoolAttribute); !ok || !readEffective.Optional {
+				t.Errorf("read_effective attribute = %#v, want an optional bool attribute", resourceSchema.Attributes["read_effective"])
+			}
+
+			if len(effective.Attributes) == 0 {
+				t.Fatal("effective attribute has no attributes")
+			}
+
+			for name, attribute := range effective.Attributes {
+				if !attribute.IsComputed() || attribute.IsOptional() || attribute.IsRequired() {
+					t.Errorf("effective.%s is not computed only", name)
+				}
+
+				if got, want := attribute.GetType(), resourceSchema.Attributes[name].GetType(); !got.Equal(want) {
+					t.Errorf("effective.%s type = %s, want %s", name, got, want)
+				}
+			}
+
+			for _, name := range append(nonBundleAttributes, "secrets_wo", "secrets_wo_version", "secrets_hash") {
+				if _, found := effective.Attributes[name]; found {
+					t.Errorf("effective.%s is mirrored, want it left out", name)
+				}
+			}
+		})
+	}
+}
+
+func TestEffectiveValue(t *testing.T) {
+	ctx := context.Background()
+
+	resourceSchema := configurationResourceSchema(t, NewFirewallResource())
+	state := tfsdk.State{Schema: resourceSchema}
+
+	effectiveType, diags := effectiveObjectType(ctx, state)
+	if diags.HasError() {
+		t.Fatalf("effectiveObjectType() diagnostics = %v", diags)
+	}
+
+	timeoutsType := resourceSchema.Attributes["timeouts"].GetType().(timeouts.Type)
+
+	model := new(firewallResourceModel)
+	model.setEntityID(config.EntityTypeTag, "production")
+	model.Timeouts = timeouts.Value{Object: types.ObjectNull(timeoutsType.AttrTypes)}
+	model.setEffective(types.ObjectNull(effectiveType.AttrTypes))
+
+	err := model.fromBundleData(config.BundleData{
+		Firewall: &config.Firewall{
+			Metadata: config.Metadata{Enabled: true, Extend: true},
+			Tables: map[config.FirewallTableName]config.FirewallTable{
+				config.Filter: {
+					config.Input: config.FirewallChain{
+						Policy: config.Target("DROP"),
+						Rules: []config.FirewallRule{
+							{Protocol: config.Protocol("tcp"), Target: config.Target("ACCEPT"), SourceIP: "0.0.0.0/0", DestinationPort: "22"},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("fromBundleData() error = %v", err)
+	}
+
+	effective, diags := effectiveValue(ctx, state, model)
+	if diags.HasError() {
+		t.Fatalf("effectiveValue() diagnostics = %v", diags)
+	}
+
+	var got struct {
+		Enabled types.Bool     `tfsdk:"enabled"`
+		Input   *firewallInput `tfsdk:"input"`
+	}
+	if diags := effective.As(ctx, &got, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("As() diagnostics = %v", diags)
+	}
+
+	if !got.Enabled.ValueBool() {
+		t.Errorf("effective enabled = %s, want true", got.Enabled)
+	}
+
+	if got.Input == nil || got.Input.Policy.ValueString() != "DROP" || len(got.Input.Rules) != 1 {
+		t.Fatalf("effective input = %+v, want the DROP policy with one rule", got.Input)
+	}
+
+	if rule := got.Input.Rules[0]; rule.Proto.ValueString() != "tcp" || rule.DstPort.ValueString() != "22" {
+		t.Errorf("effective rule = %+v, want tcp port 22", rule)
+	}
+}
@@ -38,7 +38,7 @@ type filedistributionResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *filedistributionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *filedistributionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Defines a file set to be maintained in the system.",
 		Attributes: map[string]schema.Attribute{
@@ -57,6 +57,7 @@ func (r *filedistributionResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"files": schema.ListNestedAttribute{
 				Required:    true,
 				Description: "The filesets to distribute.",
@@ -118,8 +119,15 @@ func (r *filedistributionResource) Schema(_ context.Context, _ resource.SchemaRe
 					},
 				},
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type filedistributionResourceModel struct {
@@ -184,6 +192,7 @@ func (m *filedistributionResourceModel) fromBundleData(bundleData config.BundleD
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	for _, fileSet := range data.FileSets {
 		var templates []template
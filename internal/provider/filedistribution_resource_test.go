@@ -95,6 +95,7 @@ resource "qbee_filedistribution" "test" {
 				ImportStateId:                        "tag:terraform:acctest:filedistribution",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 			// Update to be for a node
 			{
@@ -148,6 +149,7 @@ resource "qbee_filedistribution" "test" {
 				ImportStateId:                        "node:integrationtests",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "node",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 		},
 	})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,7 +34,7 @@ type filemanagerDirectoryResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *filemanagerDirectoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *filemanagerDirectoryResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The filemanager_directory resource allows you to create and manage directories in the file manager.",
 		Attributes: map[string]schema.Attribute{
@@ -42,16 +43,26 @@ func (r *filemanagerDirectoryResource) Schema(_ context.Context, _ resource.Sche
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 				MarkdownDescription: "The full path of the directory. Must not include a trailing slash. Example: /parent/directory",
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 type filemanagerDirectoryResourceModel struct {
-	Path types.String `tfsdk:"path"`
+	Path     types.String   `tfsdk:"path"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *filemanagerDirectoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.checkReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the plan
 	var plan filemanagerDirectoryResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -60,6 +71,15 @@ func (r *filemanagerDirectoryResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.defaultTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create new directory
 	directoryPath := plan.Path.ValueString()
 	pathCleaned := filepath.Clean(directoryPath)
@@ -94,6 +114,15 @@ func (r *filemanagerDirectoryResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.defaultTimeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the refreshed directory value from Qbee
 	directoryPath := state.Path.ValueString()
 
@@ -130,14 +159,28 @@ func (r *filemanagerDirectoryResource) Read(ctx context.Context, req resource.Re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *filemanagerDirectoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Error updating filemanager_directory",
-		"filemanager_directory does not support in-place updates")
-	return
+	if r.checkReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
+	// All other attributes require replacement, so only the timeouts can change in place
+	var plan filemanagerDirectoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *filemanagerDirectoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the state
 	var state filemanagerDirectoryResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -146,6 +189,15 @@ func (r *filemanagerDirectoryResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.defaultTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete old directory
 	directoryPath := state.Path.ValueString()
 	tflog.Info(ctx, fmt.Sprintf("Deleting filemanager directory '%v'", directoryPath))
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -41,7 +42,7 @@ type filemanagerFileResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *filemanagerFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *filemanagerFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The filemanager_file resource allows you to create and manage files in the file manager.",
 		Attributes: map[string]schema.Attribute{
@@ -61,18 +62,28 @@ func (r *filemanagerFileResource) Schema(_ context.Context, _ resource.SchemaReq
 				Description: "The filebase64sha256 of the source file. Required to ensure resource " +
 					"updates if the file changes.",
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 type filemanagerFileResourceModel struct {
-	Path       types.String `tfsdk:"path"`
-	SourceFile types.String `tfsdk:"sourcefile"`
-	FileSha256 types.String `tfsdk:"file_sha256"`
+	Path       types.String   `tfsdk:"path"`
+	SourceFile types.String   `tfsdk:"sourcefile"`
+	FileSha256 types.String   `tfsdk:"file_sha256"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *filemanagerFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.checkReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the plan
 	var plan filemanagerFileResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -81,6 +92,15 @@ func (r *filemanagerFileResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.defaultTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Upload the file
 	uploadPath := plan.Path.ValueString()
 	pathCleaned := filepath.Clean(uploadPath)
@@ -127,6 +147,15 @@ func (r *filemanagerFileResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.defaultTimeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	filePath := state.Path.ValueString()
 
 	// Get the current file from Qbee
@@ -159,14 +188,28 @@ func (r *filemanagerFileResource) Read(ctx context.Context, req resource.ReadReq
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *filemanagerFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Error updating filemanager_file",
-		"filemanager_file does not support updating resources inline")
-	return
+	if r.checkReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
+	// All other attributes require replacement, so only the timeouts can change in place
+	var plan filemanagerFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *filemanagerFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the state
 	var state filemanagerFileResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -175,6 +218,15 @@ func (r *filemanagerFileResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.defaultTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete file
 	filePath := state.Path.ValueString()
 	tflog.Info(ctx, fmt.Sprintf("Deleting filemanager path '%v'", filePath))
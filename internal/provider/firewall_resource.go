@@ -39,7 +39,7 @@ type firewallResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *firewallResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *firewallResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Firewall configures system firewall.",
 		Attributes: map[string]schema.Attribute{
@@ -58,6 +58,7 @@ func (r *firewallResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"input": schema.SingleNestedAttribute{
 				Required:    true,
 				Description: "The definition of the firewall configuration.",
@@ -100,8 +101,15 @@ func (r *firewallResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					},
 				},
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type firewallResourceModel struct {
@@ -149,6 +157,7 @@ func (m *firewallResourceModel) fromBundleData(bundleData config.BundleData) err
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	inputChain := data.Tables[config.Filter][config.Input]
 
@@ -57,6 +57,8 @@ resource "qbee_firewall" "test" {
 resource "qbee_firewall" "test" {
   tag = "terraform:acctest:firewall"
   extend = false
+  read_effective = true
+  commit_message = "acctest: update firewall"
 
   input = {
     policy = "ACCEPT"
@@ -75,12 +77,18 @@ resource "qbee_firewall" "test" {
 					resource.TestCheckNoResourceAttr("qbee_firewall.test", "node"),
 					resource.TestCheckResourceAttr("qbee_firewall.test", "tag", "terraform:acctest:firewall"),
 					resource.TestCheckResourceAttr("qbee_firewall.test", "extend", "false"),
+					resource.TestCheckResourceAttr("qbee_firewall.test", "commit_message", "acctest: update firewall"),
+					resource.TestCheckResourceAttrSet("qbee_firewall.test", "last_commit_sha"),
+					resource.TestCheckResourceAttrSet("qbee_firewall.test", "last_commit_created"),
 					resource.TestCheckResourceAttr("qbee_firewall.test", "input.policy", "ACCEPT"),
 					resource.TestCheckResourceAttr("qbee_firewall.test", "input.rules.#", "1"),
 					resource.TestCheckResourceAttr("qbee_firewall.test", "input.rules.0.proto", "tcp"),
 					resource.TestCheckResourceAttr("qbee_firewall.test", "input.rules.0.target", "DROP"),
 					resource.TestCheckResourceAttr("qbee_firewall.test", "input.rules.0.src_ip", "0.0.0.0/0"),
 					resource.TestCheckResourceAttr("qbee_firewall.test", "input.rules.0.dst_port", "22"),
+					resource.TestCheckResourceAttr("qbee_firewall.test", "effective.input.policy", "ACCEPT"),
+					resource.TestCheckResourceAttr("qbee_firewall.test", "effective.input.rules.#", "1"),
+					resource.TestCheckResourceAttr("qbee_firewall.test", "effective.input.rules.0.dst_port", "22"),
 				),
 			},
 			// Import testing
@@ -90,6 +98,7 @@ resource "qbee_firewall" "test" {
 				ImportStateId:                        "tag:terraform:acctest:firewall",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore:              []string{"commit_message", "last_commit_sha", "last_commit_created", "read_effective", "effective"},
 			},
 		},
 	})
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	grouptreePath = "/api/v2/grouptree/"
+
+	// grouptreePathSeparator separates the titles of the nodes in a group tree path like root/EU/Oslo.
+	grouptreePathSeparator = "/"
+)
+
+// grouptreeNode is a node of the group tree with its subtree.
+type grouptreeNode struct {
+	NodeID    string          `json:"node_id"`
+	Title     string          `json:"title"`
+	Type      string          `json:"type"`
+	Tags      []string        `json:"tags"`
+	Ancestors []string        `json:"ancestors"`
+	Nodes     []grouptreeNode `json:"nodes"`
+}
+
+// getGrouptree returns the node of the group tree with the given ID, with all nodes below it.
+func (cli *Client) getGrouptree(ctx context.Context, nodeID string) (*grouptreeNode, error) {
+	node := new(grouptreeNode)
+
+	if err := cli.Call(ctx, http.MethodGet, grouptreePath+url.PathEscape(nodeID), nil, node); err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// walk calls fn for the node and every node below it, depth-first, with the path of the node.
+// The path of the top node is its node ID, and the path of the nodes below it appends their titles.
+func (n grouptreeNode) walk(fn func(node grouptreeNode, path string)) {
+	n.walkPath(n.NodeID, fn)
+}
+
+func (n grouptreeNode) walkPath(path string, fn func(node grouptreeNode, path string)) {
+	fn(n, path)
+
+	for _, child := range n.Nodes {
+		child.walkPath(path+grouptreePathSeparator+child.Title, fn)
+	}
+}
+
+// parentID returns the ID of the parent node, or an empty string for the root of the tree.
+func (n grouptreeNode) parentID() string {
+	// The ancestors of a node end with the node itself
+	if len(n.Ancestors) < 2 {
+		return ""
+	}
+
+	return n.Ancestors[len(n.Ancestors)-2]
+}
+
+// resolve returns the node at the given path of titles below the node, where the first element of the
+// path is the ID of the node itself. It fails if no node, or more than one node, has the path.
+func (n grouptreeNode) resolve(path string) (grouptreeNode, error) {
+	titles := strings.Split(path, grouptreePathSeparator)
+	if titles[0] != n.NodeID {
+		return grouptreeNode{}, fmt.Errorf("path %q must start with %q", path, n.NodeID)
+	}
+
+	node := n
+	for i, title := range titles[1:] {
+		var matches []grouptreeNode
+		for _, child := range node.Nodes {
+			if child.Title == title {
+				matches = append(matches, child)
+			}
+		}
+
+		parentPath := strings.Join(titles[:i+1], grouptreePathSeparator)
+
+		switch len(matches) {
+		case 0:
+			return grouptreeNode{}, fmt.Errorf("no node titled %q in %q", title, parentPath)
+		case 1:
+			node = matches[0]
+		default:
+			return grouptreeNode{}, fmt.Errorf("%d nodes are titled %q in %q", len(matches), title, parentPath)
+		}
+	}
+
+	return node, nil
+}
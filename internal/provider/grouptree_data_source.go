@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &grouptreeDataSource{}
+	_ datasource.DataSourceWithConfigure = &grouptreeDataSource{}
+)
+
+const errorReadingGrouptree = "error reading grouptree"
+
+// NewGrouptreeDataSource is a helper function to simplify the provider implementation.
+func NewGrouptreeDataSource() datasource.DataSource {
+	return &grouptreeDataSource{
+		dataSourceBase: newDataSourceBase("grouptree"),
+	}
+}
+
+type grouptreeDataSource struct {
+	dataSourceBase
+}
+
+// grouptreeNodeModel describes a node of the group tree, as returned by the grouptree data sources.
+type grouptreeNodeModel struct {
+	NodeID    types.String `tfsdk:"node_id"`
+	Title     types.String `tfsdk:"title"`
+	Type      types.String `tfsdk:"type"`
+	Tags      []string     `tfsdk:"tags"`
+	Ancestors []string     `tfsdk:"ancestors"`
+	ParentID  types.String `tfsdk:"parent_id"`
+	Path      types.String `tfsdk:"path"`
+}
+
+type grouptreeDataSourceModel struct {
+	NodeID   types.String         `tfsdk:"node_id"`
+	Nodes    []grouptreeNodeModel `tfsdk:"nodes"`
+	Timeouts timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// grouptreeNodeAttributes returns the computed schema attributes describing a node of the group tree.
+func grouptreeNodeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"node_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the node.",
+		},
+		"title": schema.StringAttribute{
+			Computed:    true,
+			Description: "The title of the node.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the node, for example group or device.",
+		},
+		"tags": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The tags of the node.",
+		},
+		"ancestors": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The IDs of the ancestors of the node, from the root of the tree down to the node itself.",
+		},
+		"parent_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the parent of the node, or null for the root of the tree.",
+		},
+		"path": schema.StringAttribute{
+			Computed: true,
+			Description: "The path of the node, made of the ID of the node the tree was read from, followed by " +
+				"the titles of the nodes below it, separated by slashes. For example root/EU/Oslo.",
+		},
+	}
+}
+
+// newGrouptreeNodeModel converts a node of the group tree at the given path to its model.
+func newGrouptreeNodeModel(node grouptreeNode, path string) grouptreeNodeModel {
+	return grouptreeNodeModel{
+		NodeID:    types.StringValue(node.NodeID),
+		Title:     types.StringValue(node.Title),
+		Type:      types.StringValue(node.Type),
+		Tags:      node.Tags,
+		Ancestors: node.Ancestors,
+		ParentID:  nullableStringValue(node.parentID()),
+		Path:      types.StringValue(path),
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *grouptreeDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grouptree reads the group tree, or the subtree below a node, as a flat list of nodes.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the node to read the subtree from. Defaults to the root of the tree.",
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed: true,
+				Description: "The nodes of the tree, depth-first, starting with the node the tree was read from. " +
+					"This includes both groups and devices.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: grouptreeNodeAttributes(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *grouptreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state grouptreeDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, d.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	nodeID := nodeIDAllDevices
+	if !state.NodeID.IsNull() {
+		nodeID = state.NodeID.ValueString()
+	}
+
+	tree, err := d.client.getGrouptree(ctx, nodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadingGrouptree,
+			"error reading the grouptree of "+nodeID+": "+err.Error())
+		return
+	}
+
+	state.Nodes = []grouptreeNodeModel{}
+	tree.walk(func(node grouptreeNode, path string) {
+		state.Nodes = append(state.Nodes, newGrouptreeNodeModel(node, path))
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
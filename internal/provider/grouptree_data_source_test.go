@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGrouptreeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "qbee_grouptree_group" "test" {
+  id = "terraform-acctest-grouptree-datasource"
+  title = "Terraform grouptree data source"
+  ancestor = "root"
+}
+
+data "qbee_grouptree" "test" {
+  node_id = qbee_grouptree_group.test.id
+}
+
+data "qbee_grouptree_node" "test" {
+  path = "root/${qbee_grouptree_group.test.title}"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.qbee_grouptree.test", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.qbee_grouptree.test", "nodes.0.node_id", "terraform-acctest-grouptree-datasource"),
+					resource.TestCheckResourceAttr("data.qbee_grouptree.test", "nodes.0.parent_id", "root"),
+					resource.TestCheckResourceAttr("data.qbee_grouptree_node.test", "node_id", "terraform-acctest-grouptree-datasource"),
+					resource.TestCheckResourceAttr("data.qbee_grouptree_node.test", "type", "group"),
+				),
+			},
+		},
+	})
+}
+
+func testGrouptree() grouptreeNode {
+	return grouptreeNode{
+		NodeID:    "root",
+		Title:     "All devices",
+		Ancestors: []string{"root"},
+		Nodes: []grouptreeNode{
+			{
+				NodeID:    "eu",
+				Title:     "EU",
+				Ancestors: []string{"root", "eu"},
+				Nodes: []grouptreeNode{
+					{NodeID: "oslo", Title: "Oslo", Ancestors: []string{"root", "eu", "oslo"}},
+					{NodeID: "berlin-1", Title: "Berlin", Ancestors: []string{"root", "eu", "berlin-1"}},
+					{NodeID: "berlin-2", Title: "Berlin", Ancestors: []string{"root", "eu", "berlin-2"}},
+				},
+			},
+		},
+	}
+}
+
+func TestGrouptreeWalk(t *testing.T) {
+	var paths []string
+	testGrouptree().walk(func(node grouptreeNode, path string) {
+		paths = append(paths, path)
+	})
+
+	want := []string{"root", "root/EU", "root/EU/Oslo", "root/EU/Berlin", "root/EU/Berlin"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("walk() paths = %v, want %v", paths, want)
+	}
+}
+
+func TestGrouptreeResolve(t *testing.T) {
+	tree := testGrouptree()
+
+	tests := []struct {
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{path: "root", want: "root"},
+		{path: "root/EU/Oslo", want: "oslo"},
+		{path: "root/EU/Paris", wantErr: true},
+		{path: "root/EU/Berlin", wantErr: true},
+		{path: "eu/Oslo", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			node, err := tree.resolve(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolve() error = %v, want error %v", err, tt.wantErr)
+			}
+
+			if node.NodeID != tt.want {
+				t.Errorf("resolve() = %s, want %s", node.NodeID, tt.want)
+			}
+
+			if err == nil && tt.path != "root" && node.parentID() != "eu" {
+				t.Errorf("parentID() = %s, want eu", node.parentID())
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,7 +34,7 @@ type grouptreeGroupResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *grouptreeGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *grouptreeGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The grouptree_group resource allows you to create and manage groups in the grouptree.",
 		Attributes: map[string]schema.Attribute{
@@ -55,21 +56,32 @@ func (r *grouptreeGroupResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:    true,
 				Description: "A list of tags to add to the group",
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 type grouptreeGroupResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Title    types.String `tfsdk:"title"`
-	Ancestor types.String `tfsdk:"ancestor"`
-	Tags     types.List   `tfsdk:"tags"`
+	ID       types.String   `tfsdk:"id"`
+	Title    types.String   `tfsdk:"title"`
+	Ancestor types.String   `tfsdk:"ancestor"`
+	Tags     types.List     `tfsdk:"tags"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 const nodeIDAllDevices = "root"
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *grouptreeGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.checkReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the plan
 	var plan grouptreeGroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -78,6 +90,15 @@ func (r *grouptreeGroupResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.defaultTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	nodeID := plan.ID.ValueString()
 	ancestor := plan.Ancestor.ValueString()
 	title := plan.Title.ValueString()
@@ -145,6 +166,15 @@ func (r *grouptreeGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.defaultTimeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	nodeID := state.ID.ValueString()
 
 	// Read the real status
@@ -175,6 +205,10 @@ func (r *grouptreeGroupResource) Read(ctx context.Context, req resource.ReadRequ
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *grouptreeGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
 	// Get the current state
 	var state grouptreeGroupResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -190,6 +224,15 @@ func (r *grouptreeGroupResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.defaultTimeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	nodeID := state.ID.ValueString()
 
 	// Check if we should move the group
@@ -255,6 +298,10 @@ func (r *grouptreeGroupResource) Update(ctx context.Context, req resource.Update
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *grouptreeGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the state
 	var state grouptreeGroupResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -263,6 +310,15 @@ func (r *grouptreeGroupResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.defaultTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the resource
 	nodeID := state.ID.ValueString()
 	parentID := state.Ancestor.ValueString()
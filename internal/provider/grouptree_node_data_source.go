@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &grouptreeNodeDataSource{}
+	_ datasource.DataSourceWithConfigure = &grouptreeNodeDataSource{}
+)
+
+// NewGrouptreeNodeDataSource is a helper function to simplify the provider implementation.
+func NewGrouptreeNodeDataSource() datasource.DataSource {
+	return &grouptreeNodeDataSource{
+		dataSourceBase: newDataSourceBase("grouptree_node"),
+	}
+}
+
+type grouptreeNodeDataSource struct {
+	dataSourceBase
+}
+
+type grouptreeNodeDataSourceModel struct {
+	grouptreeNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Schema defines the schema for the data source.
+func (d *grouptreeNodeDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := grouptreeNodeAttributes()
+	attributes["path"] = schema.StringAttribute{
+		Required: true,
+		Description: "The path of the node to look up, made of the ID of a node followed by the titles of the " +
+			"nodes below it, separated by slashes. For example root/EU/Oslo resolves the node titled Oslo in the " +
+			"node titled EU directly below the root of the tree.",
+	}
+	attributes["timeouts"] = timeouts.Attributes(ctx)
+
+	resp.Schema = schema.Schema{
+		Description: "Grouptree node resolves a node of the group tree by its path of titles.",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *grouptreeNodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config grouptreeNodeDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	nodePath := config.Path.ValueString()
+	topNodeID, _, _ := strings.Cut(nodePath, grouptreePathSeparator)
+
+	tree, err := d.client.getGrouptree(ctx, topNodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadingGrouptree,
+			"error reading the grouptree of "+topNodeID+": "+err.Error())
+		return
+	}
+
+	node, err := tree.resolve(nodePath)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), errorReadingGrouptree, err.Error())
+		return
+	}
+
+	state := grouptreeNodeDataSourceModel{
+		grouptreeNodeModel: newGrouptreeNodeModel(node, nodePath),
+		Timeouts:           config.Timeouts,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// httpClientConfig defines the network settings of the HTTP client used by the Qbee API client.
+type httpClientConfig struct {
+	// CACertFile is the path to a PEM encoded CA bundle that is trusted in addition to the system roots.
+	CACertFile string
+
+	// CACertPEM is a PEM encoded CA bundle that is trusted in addition to the system roots.
+	CACertPEM string
+
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+
+	// ProxyURL is the URL of the HTTP proxy to use. If empty, the standard proxy environment variables are used.
+	ProxyURL string
+
+	// ClientCertFile and ClientKeyFile are paths to the PEM encoded client certificate and private key.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// ClientCertPEM and ClientKeyPEM are the PEM encoded client certificate and private key.
+	ClientCertPEM string
+	ClientKeyPEM  string
+
+	// Retry defines how requests failing with transient errors are retried.
+	Retry retryConfig
+}
+
+// newHTTPClient creates an HTTP client with the TLS, proxy and retry settings from the given configuration.
+// Requests are logged with the logger of the given context, see loggingTransport.
+func newHTTPClient(ctx context.Context, cfg httpClientConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %q: %w", cfg.ProxyURL, err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return &http.Client{Transport: newRetryTransport(newLoggingTransport(ctx, transport), cfg.Retry)}, nil
+}
+
+// tlsConfig builds the TLS configuration from the CA bundle and client certificate settings.
+func (cfg httpClientConfig) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	caBundle, err := readPEM(cfg.CACertFile, cfg.CACertPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error reading CA certificate: %w", err)
+	}
+
+	if len(caBundle) > 0 {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("error reading CA certificate: no PEM encoded certificates found")
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if cfg.ClientCertFile != "" && cfg.ClientCertPEM != "" {
+		return nil, fmt.Errorf("client certificate can be set either from a file or as PEM, not both")
+	}
+
+	if cfg.ClientKeyFile != "" && cfg.ClientKeyPEM != "" {
+		return nil, fmt.Errorf("client key can be set either from a file or as PEM, not both")
+	}
+
+	clientCert, err := readPEM(cfg.ClientCertFile, cfg.ClientCertPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error reading client certificate: %w", err)
+	}
+
+	clientKey, err := readPEM(cfg.ClientKeyFile, cfg.ClientKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error reading client key: %w", err)
+	}
+
+	if len(clientCert) > 0 || len(clientKey) > 0 {
+		certificate, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEM returns the contents of the given file followed by the given inline PEM data.
+func readPEM(path, pem string) ([]byte, error) {
+	var data []byte
+
+	if path != "" {
+		fileData, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		data = append(data, fileData...)
+		data = append(data, '\n')
+	}
+
+	if pem != "" {
+		data = append(data, pem...)
+	}
+
+	return data, nil
+}
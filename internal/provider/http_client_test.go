@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestNewHTTPClientTrustsConfiguredCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	tests := []struct {
+		name    string
+		cfg     httpClientConfig
+		wantErr bool
+	}{
+		{name: "system roots only", cfg: httpClientConfig{}, wantErr: true},
+		{name: "ca bundle", cfg: httpClientConfig{CACertPEM: caPEM}},
+		{name: "insecure skip verify", cfg: httpClientConfig{InsecureSkipVerify: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient, err := newHTTPClient(t.Context(), tt.cfg)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			resp, err := httpClient.Get(server.URL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+	httpClient, err := newHTTPClient(t.Context(), httpClientConfig{ProxyURL: "http://proxy.example.com:3128"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, "https://www.app.qbee.io", nil)
+
+	proxyURL, err := httpClient.Transport.(*retryTransport).next.(*loggingTransport).next.(*http.Transport).Proxy(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want, _ := url.Parse("http://proxy.example.com:3128")
+	if proxyURL.String() != want.String() {
+		t.Errorf("got proxy %v, want %v", proxyURL, want)
+	}
+}
+
+func TestNewHTTPClientInvalidSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  httpClientConfig
+	}{
+		{name: "invalid ca bundle", cfg: httpClientConfig{CACertPEM: "not a certificate"}},
+		{name: "missing ca file", cfg: httpClientConfig{CACertFile: "testfiles/does-not-exist.pem"}},
+		{name: "client cert without key", cfg: httpClientConfig{ClientCertPEM: "not a certificate"}},
+		{name: "client cert from file and pem", cfg: httpClientConfig{ClientCertFile: "cert.pem", ClientCertPEM: "cert"}},
+		{name: "invalid proxy url", cfg: httpClientConfig{ProxyURL: "://proxy"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newHTTPClient(t.Context(), tt.cfg); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
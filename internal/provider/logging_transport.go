@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// httpLogSubsystem is the tflog subsystem of the HTTP request logs.
+	// Its level can be set separately from the provider logs using httpLogLevelEnv.
+	httpLogSubsystem = "qbee_http"
+
+	// httpLogLevelEnv is the environment variable setting the level of the HTTP request logs.
+	httpLogLevelEnv = "TF_LOG_PROVIDER_QBEE_HTTP"
+
+	// redactedValue replaces sensitive values in the HTTP request logs.
+	redactedValue = "***"
+
+	// maxLoggedBodySize is the maximum size of request and response bodies that are logged.
+	maxLoggedBodySize = 64 * 1024
+
+	// bootstrapKeysPath is the API path of bootstrap keys, whose identifier is the secret key itself.
+	bootstrapKeysPath = "/api/v2/bootstrapkeys"
+)
+
+// sensitiveKeyParts are the parts of JSON keys and headers whose values are masked in the HTTP request logs.
+// Keys are matched case-insensitively, so "password" also masks "passwordhash" and registry "password" fields,
+// and "secret" masks the "secrets" of the parameters bundle as a whole.
+var sensitiveKeyParts = []string{"password", "secret", "token", "authorization", "cookie", "credential", "passphrase"}
+
+// loggingTransport is an http.RoundTripper that logs the requests to and responses from the Qbee API
+// at TRACE level in the qbee_http subsystem, with sensitive values masked.
+type loggingTransport struct {
+	next http.RoundTripper
+
+	// logCtx holds the qbee_http subsystem logger. Request contexts come from the resources and data sources,
+	// which don't have the subsystem, so it is created once when the provider is configured.
+	logCtx context.Context
+
+	// enabled is false when TRACE logs of the subsystem are discarded, so that bodies are not buffered for nothing.
+	enabled bool
+}
+
+// newLoggingTransport wraps the given transport with logging of requests and responses in the qbee_http
+// subsystem of the logger of the given context.
+func newLoggingTransport(ctx context.Context, next http.RoundTripper) *loggingTransport {
+	return &loggingTransport{
+		next:    next,
+		logCtx:  tflog.NewSubsystem(ctx, httpLogSubsystem, tflog.WithLevelFromEnv(httpLogLevelEnv)),
+		enabled: httpTraceEnabled(os.Getenv),
+	}
+}
+
+// httpTraceEnabled reports if the HTTP request logs are written, based on the log levels in the environment.
+// Setting httpLogLevelEnv enables the logs on its own. Otherwise, the subsystem has the level of the provider
+// logs, which Terraform only writes at the level of TF_LOG_PROVIDER or TF_LOG.
+func httpTraceEnabled(getenv func(string) string) bool {
+	if level := getenv(httpLogLevelEnv); level != "" {
+		return isTraceLevel(level)
+	}
+
+	if level := getenv("TF_LOG_PROVIDER_QBEE"); level != "" && !isTraceLevel(level) {
+		return false
+	}
+
+	return isTraceLevel(cmp.Or(getenv("TF_LOG_PROVIDER"), getenv("TF_LOG")))
+}
+
+// isTraceLevel returns true if the log level includes TRACE logs. Terraform writes JSON logs at TRACE level.
+func isTraceLevel(level string) bool {
+	return strings.EqualFold(level, "TRACE") || strings.EqualFold(level, "JSON")
+}
+
+// RoundTrip logs the request, executes it and logs the response.
+func (t *loggingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if !t.enabled {
+		return t.next.RoundTrip(request)
+	}
+
+	ctx := t.logCtx
+
+	requestURL := redactURL(request.URL)
+
+	requestBody, err := peekRequestBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemTrace(ctx, httpLogSubsystem, "Sending API request", map[string]any{
+		"method":  request.Method,
+		"url":     requestURL,
+		"headers": redactHeaders(request.Header),
+		"body":    redactBody(request.URL.Path, request.Header.Get("Content-Type"), requestBody),
+	})
+
+	start := time.Now()
+	response, err := t.next.RoundTrip(request)
+	latency := time.Since(start)
+
+	if err != nil {
+		tflog.SubsystemTrace(ctx, httpLogSubsystem, "API request failed", map[string]any{
+			"method":     request.Method,
+			"url":        requestURL,
+			"latency_ms": latency.Milliseconds(),
+			"error":      err.Error(),
+		})
+
+		return nil, err
+	}
+
+	responseBody, err := peekResponseBody(response)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemTrace(ctx, httpLogSubsystem, "Received API response", map[string]any{
+		"method":     request.Method,
+		"url":        requestURL,
+		"status":     response.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+		"headers":    redactHeaders(response.Header),
+		"body":       redactBody(request.URL.Path, response.Header.Get("Content-Type"), responseBody),
+	})
+
+	return response, nil
+}
+
+// peekRequestBody returns the body of a JSON request, or of a request without content type, leaving the
+// request body intact. The bodies of other requests, like file uploads, are not read.
+func peekRequestBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if contentType := request.Header.Get("Content-Type"); contentType != "" && !isJSONContent(contentType) {
+		return nil, nil
+	}
+
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+
+		return io.ReadAll(body)
+	}
+
+	data, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Body.Close()
+	request.Body = io.NopCloser(bytes.NewReader(data))
+
+	return data, nil
+}
+
+// peekResponseBody reads the body of a JSON response and replaces it with a copy.
+// The bodies of other responses, like file downloads, are not read.
+func peekResponseBody(response *http.Response) ([]byte, error) {
+	if response.Body == nil || !isJSONContent(response.Header.Get("Content-Type")) {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(data))
+
+	return data, err
+}
+
+// isJSONContent returns true if the content type is JSON.
+func isJSONContent(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// isSensitiveKey returns true if the values of the given JSON key or header must be masked.
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isOneTimeCode returns true if the JSON key and value are the one-time code of a two-factor login.
+// Those are strings, unlike the numeric codes of error responses, which are kept for troubleshooting.
+func isOneTimeCode(key string, value any) bool {
+	_, isString := value.(string)
+
+	return isString && strings.EqualFold(key, "code")
+}
+
+// redactURL returns the URL with sensitive query parameters and bootstrap keys masked.
+func redactURL(requestURL *url.URL) string {
+	redacted := *requestURL
+	redacted.User = nil
+
+	if rest, found := strings.CutPrefix(redacted.Path, bootstrapKeysPath+"/"); found && rest != "" {
+		redacted.Path = bootstrapKeysPath + "/" + redactedValue
+		redacted.RawPath = redacted.Path
+	}
+
+	query := redacted.Query()
+	for key := range query {
+		if isSensitiveKey(key) {
+			query.Set(key, redactedValue)
+		}
+	}
+	redacted.RawQuery = query.Encode()
+
+	return redacted.String()
+}
+
+// redactHeaders returns the headers with sensitive values masked.
+func redactHeaders(headers http.Header) map[string]string {
+	redacted := make(map[string]string, len(headers))
+
+	for key, values := range headers {
+		if isSensitiveKey(key) {
+			redacted[key] = redactedValue
+		} else {
+			redacted[key] = strings.Join(values, ", ")
+		}
+	}
+
+	return redacted
+}
+
+// redactBody returns the body with the values of sensitive keys masked.
+// Bodies that are not JSON are replaced with a short description.
+func redactBody(requestPath, contentType string, body []byte) string {
+	if len(body) == 0 {
+		if contentType != "" && !isJSONContent(contentType) {
+			return fmt.Sprintf("<%s content>", contentType)
+		}
+
+		return ""
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON content>", len(body))
+	}
+
+	value = redactJSON(value, strings.HasPrefix(requestPath, bootstrapKeysPath))
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("<%d bytes of JSON>", len(body))
+	}
+
+	if len(data) > maxLoggedBodySize {
+		return string(data[:maxLoggedBodySize]) + "...(truncated)"
+	}
+
+	return string(data)
+}
+
+// redactJSON masks the values of sensitive keys in the decoded JSON value.
+// For bootstrap keys, the id is masked as well, as it is the key used to register devices.
+func redactJSON(value any, bootstrapKeys bool) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, nested := range typed {
+			if isSensitiveKey(key) || (bootstrapKeys && key == "id") || isOneTimeCode(key, nested) {
+				typed[key] = redactedValue
+				continue
+			}
+
+			typed[key] = redactJSON(nested, bootstrapKeys)
+		}
+	case []any:
+		for i, nested := range typed {
+			typed[i] = redactJSON(nested, bootstrapKeys)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name: "login",
+			path: "/api/v2/login",
+			body: `{"email":"qbee@example.com","password":"secret"}`,
+			want: `{"email":"qbee@example.com","password":"***"}`,
+		},
+		{
+			name: "two-factor code",
+			path: "/api/v2/challenge-verify",
+			body: `{"challenge":"abc","code":"123456"}`,
+			want: `{"challenge":"abc","code":"***"}`,
+		},
+		{
+			name: "error code",
+			path: "/api/v2/change",
+			body: `{"error":{"code":400,"message":"invalid"}}`,
+			want: `{"error":{"code":400,"message":"invalid"}}`,
+		},
+		{
+			name: "parameters secrets",
+			path: "/api/v2/change",
+			body: `{"content":{"parameters":[{"key":"a","value":"b"}],"secrets":[{"key":"c","value":"d"}]}}`,
+			want: `{"content":{"parameters":[{"key":"a","value":"b"}],"secrets":"***"}}`,
+		},
+		{
+			name: "registry credentials",
+			path: "/api/v2/change",
+			body: `{"content":{"registry_auths":[{"server":"r","username":"u","password":"p"}]}}`,
+			want: `{"content":{"registry_auths":[{"password":"***","server":"r","username":"u"}]}}`,
+		},
+		{
+			name: "user password hashes",
+			path: "/api/v2/change",
+			body: `{"content":{"users":[{"username":"u","passwordhash":"h"}]}}`,
+			want: `{"content":{"users":[{"passwordhash":"***","username":"u"}]}}`,
+		},
+		{
+			name: "bootstrap key",
+			path: "/api/v2/bootstrapkeys",
+			body: `{"id":"key","group_id":"root"}`,
+			want: `{"group_id":"root","id":"***"}`,
+		},
+		{
+			name: "session token",
+			path: "/api/v2/login",
+			body: `{"token":"jwt"}`,
+			want: `{"token":"***"}`,
+		},
+		{
+			name:        "file upload",
+			path:        "/api/v2/file",
+			contentType: "multipart/form-data",
+			want:        "<multipart/form-data content>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody(tt.path, tt.contentType, []byte(tt.body)); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	tests := map[string]string{
+		"https://www.app.qbee.io/api/v2/bootstrapkeys/secret-key": "https://www.app.qbee.io/api/v2/bootstrapkeys/***",
+		"https://www.app.qbee.io/api/v2/bootstrapkeys":            "https://www.app.qbee.io/api/v2/bootstrapkeys",
+		"https://www.app.qbee.io/api/v2/config?token=abc&tag=x":   "https://www.app.qbee.io/api/v2/config?tag=x&token=%2A%2A%2A",
+	}
+
+	for rawURL, want := range tests {
+		parsed, err := url.Parse(rawURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := redactURL(parsed); got != want {
+			t.Errorf("redactURL(%s) = %s, want %s", rawURL, got, want)
+		}
+	}
+}
+
+func TestLoggingTransport(t *testing.T) {
+	const requestBody = `{"email":"qbee@example.com","password":"request-secret"}`
+	const responseBody = `{"token":"response-secret"}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != requestBody {
+			t.Errorf("server got body %s, want %s", body, requestBody)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responseBody))
+	}))
+	defer server.Close()
+
+	t.Setenv(httpLogLevelEnv, "TRACE")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/v2/login", strings.NewReader(requestBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer header-secret")
+
+	response, err := newLoggingTransport(ctx, http.DefaultTransport).RoundTrip(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer response.Body.Close()
+
+	body, _ := io.ReadAll(response.Body)
+	if string(body) != responseBody {
+		t.Errorf("got response body %s, want %s", body, responseBody)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("got %d log entries, want 2: %s", len(entries), output.String())
+	}
+
+	for _, entry := range entries {
+		if entry["@module"] != "provider."+httpLogSubsystem {
+			t.Errorf("got module %v, want provider.%s", entry["@module"], httpLogSubsystem)
+		}
+	}
+
+	if status := entries[1]["status"]; status != float64(http.StatusOK) {
+		t.Errorf("got status %v, want %d", status, http.StatusOK)
+	}
+
+	for _, secret := range []string{"request-secret", "response-secret", "header-secret"} {
+		if strings.Contains(output.String(), secret) {
+			t.Errorf("log output contains %q: %s", secret, output.String())
+		}
+	}
+}
+
+func TestHTTPTraceEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{name: "no logs", want: false},
+		{name: "subsystem trace", env: map[string]string{httpLogLevelEnv: "TRACE"}, want: true},
+		{name: "subsystem debug", env: map[string]string{httpLogLevelEnv: "DEBUG", "TF_LOG": "TRACE"}, want: false},
+		{name: "terraform trace", env: map[string]string{"TF_LOG": "trace"}, want: true},
+		{name: "terraform json", env: map[string]string{"TF_LOG": "JSON"}, want: true},
+		{name: "terraform debug", env: map[string]string{"TF_LOG": "DEBUG"}, want: false},
+		{name: "provider trace", env: map[string]string{"TF_LOG": "INFO", "TF_LOG_PROVIDER": "TRACE"}, want: true},
+		{name: "qbee provider debug", env: map[string]string{"TF_LOG": "TRACE", "TF_LOG_PROVIDER_QBEE": "DEBUG"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string { return tt.env[key] }
+
+			if got := httpTraceEnabled(getenv); got != tt.want {
+				t.Errorf("httpTraceEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// staticTransport is an http.RoundTripper returning the same response to all requests.
+type staticTransport struct {
+	response *http.Response
+	requests []*http.Request
+}
+
+func (t *staticTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, request)
+	return t.response, nil
+}
+
+func TestLoggingTransportDisabled(t *testing.T) {
+	t.Setenv(httpLogLevelEnv, "DEBUG")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+
+	responseBody := io.NopCloser(strings.NewReader(`{"token":"secret"}`))
+	next := &staticTransport{response: &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       responseBody,
+	}}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://localhost/api/v2/login", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	requestBody := request.Body
+
+	response, err := newLoggingTransport(ctx, next).RoundTrip(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(next.requests) != 1 || next.requests[0].Body != requestBody {
+		t.Error("the request body was replaced while logging is disabled")
+	}
+
+	if response.Body != responseBody {
+		t.Error("the response body was replaced while logging is disabled")
+	}
+
+	if output.Len() != 0 {
+		t.Errorf("got log output %s, want none", output.String())
+	}
+}
@@ -38,7 +38,7 @@ type metricsMonitorResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *metricsMonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *metricsMonitorResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "MetricsMonitor configures on-agent metrics monitoring.",
 		Attributes: map[string]schema.Attribute{
@@ -57,6 +57,7 @@ func (r *metricsMonitorResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"metrics": schema.ListNestedAttribute{
 				Required:    true,
 				Description: "List of monitors for individual metrics",
@@ -77,8 +78,15 @@ func (r *metricsMonitorResource) Schema(_ context.Context, _ resource.SchemaRequ
 					},
 				},
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type metricsMonitorResourceModel struct {
@@ -103,6 +111,7 @@ func (m *metricsMonitorResourceModel) fromBundleData(bundleData config.BundleDat
 	}
 
 	m.Extend = types.BoolValue(data.Extend)
+	m.Enabled = types.BoolValue(data.Enabled)
 
 	for _, metric := range data.Metrics {
 		monitor := metricMonitor{
@@ -72,6 +72,7 @@ resource "qbee_metrics_monitor" "test" {
 				ImportStateId:                        "tag:terraform:acctest:metricsmonitor",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 			// Update to be for a node
 			{
@@ -112,6 +113,7 @@ resource "qbee_metrics_monitor" "test" {
 				ImportStateId:                        "node:integrationtests",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "node",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 		},
 	})
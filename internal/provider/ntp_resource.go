@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	// The time zones are embedded, as the tz database of the system running Terraform may be missing or outdated.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.qbee.io/client/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resourceModelManager                  = &ntpResourceModel{}
+	_ resource.Resource                     = &ntpResource{}
+	_ resource.ResourceWithConfigure        = &ntpResource{}
+	_ resource.ResourceWithConfigValidators = &ntpResource{}
+	_ resource.ResourceWithImportState      = &ntpResource{}
+)
+
+// NewNTPResource is a helper function to simplify the provider implementation.
+func NewNTPResource() resource.Resource {
+	return &ntpResource{
+		configurationResource: configurationResource{
+			resourceBase: newResourceBase(config.NTPBundle),
+			modelFactory: func() any {
+				return new(ntpResourceModel)
+			},
+		},
+	}
+}
+
+type ntpResource struct {
+	configurationResource
+}
+
+// Schema defines the schema for the resource.
+func (r *ntpResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "NTP configures the time servers used to synchronize the clock of the system, and its time zone.",
+		Attributes: map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The tag for which to set the configuration. Either tag or node is required.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"node": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The node for which to set the configuration. Either tag or node is required.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"extend": schema.BoolAttribute{
+				Required: true,
+				Description: "If the configuration should extend configuration from the parent nodes of the node " +
+					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
+			},
+			"enabled": enabledAttribute(),
+			"servers": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The hostnames or IP addresses of the NTP servers, in order of preference.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"time_zone": schema.StringAttribute{
+				Optional:    true,
+				Description: "The time zone of the system, as a name from the tz database, for example Europe/Oslo.",
+				Validators: []validator.String{
+					timeZoneValidator{},
+				},
+			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
+		},
+	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
+}
+
+// timeZoneValidator validates that a string is the name of a time zone from the tz database.
+type timeZoneValidator struct{}
+
+func (v timeZoneValidator) Description(_ context.Context) string {
+	return "value must be a time zone name from the tz database, for example Europe/Oslo"
+}
+
+func (v timeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeZoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// LoadLocation also accepts the empty string and Local, which are not names from the tz database
+	name := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(name); err != nil || name == "" || name == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid time zone",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), name),
+		)
+	}
+}
+
+type ntpResourceModel struct {
+	configurationResourceModel
+	Servers  []types.String `tfsdk:"servers"`
+	TimeZone types.String   `tfsdk:"time_zone"`
+}
+
+func (m ntpResourceModel) getConfigBundle() config.Bundle {
+	return config.NTPBundle
+}
+
+func (m *ntpResourceModel) fromBundleData(bundleData config.BundleData) error {
+	data := bundleData.NTP
+	if data == nil {
+		return fmt.Errorf("ntp bundle data is nil")
+	}
+
+	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
+
+	m.Servers = make([]types.String, 0, len(data.Servers))
+	for _, server := range data.Servers {
+		m.Servers = append(m.Servers, types.StringValue(server.Server))
+	}
+
+	if data.TimeZone != "" {
+		m.TimeZone = types.StringValue(data.TimeZone)
+	}
+
+	return nil
+}
+
+func (m ntpResourceModel) toBundleData(metadata config.Metadata) any {
+	bundleData := config.NTP{
+		Metadata: metadata,
+	}
+
+	if metadata.Reset {
+		return bundleData
+	}
+
+	for _, server := range m.Servers {
+		bundleData.Servers = append(bundleData.Servers, config.NTPServer{
+			Server: server.ValueString(),
+		})
+	}
+
+	if !m.TimeZone.IsNull() {
+		bundleData.TimeZone = m.TimeZone.ValueString()
+	}
+
+	return bundleData
+}
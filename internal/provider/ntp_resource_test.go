@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNTPResource(t *testing.T) {
+	var lastCommitSHA string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "qbee_ntp" "test" {
+  tag = "terraform:acctest:ntp"
+  extend = true
+  servers = ["0.pool.ntp.org", "1.pool.ntp.org"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_ntp.test", "tag", "terraform:acctest:ntp"),
+					resource.TestCheckNoResourceAttr("qbee_ntp.test", "node"),
+					resource.TestCheckResourceAttr("qbee_ntp.test", "extend", "true"),
+					resource.TestCheckResourceAttr("qbee_ntp.test", "servers.#", "2"),
+					resource.TestCheckResourceAttr("qbee_ntp.test", "servers.0", "0.pool.ntp.org"),
+					resource.TestCheckResourceAttr("qbee_ntp.test", "servers.1", "1.pool.ntp.org"),
+					resource.TestCheckNoResourceAttr("qbee_ntp.test", "time_zone"),
+				),
+			},
+			// Update with a time zone
+			{
+				Config: providerConfig + `
+resource "qbee_ntp" "test" {
+  tag = "terraform:acctest:ntp"
+  extend = false
+  servers = ["time.example.com"]
+  time_zone = "Europe/Oslo"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_ntp.test", "extend", "false"),
+					resource.TestCheckResourceAttr("qbee_ntp.test", "servers.#", "1"),
+					resource.TestCheckResourceAttr("qbee_ntp.test", "servers.0", "time.example.com"),
+					resource.TestCheckResourceAttr("qbee_ntp.test", "time_zone", "Europe/Oslo"),
+					resource.TestCheckResourceAttrWith("qbee_ntp.test", "last_commit_sha", func(value string) error {
+						lastCommitSHA = value
+						return nil
+					}),
+				),
+			},
+			// Changing only the commit message does not create a commit
+			{
+				Config: providerConfig + `
+resource "qbee_ntp" "test" {
+  tag = "terraform:acctest:ntp"
+  extend = false
+  servers = ["time.example.com"]
+  time_zone = "Europe/Oslo"
+  commit_message = "terraform acctest: new message"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_ntp.test", "commit_message", "terraform acctest: new message"),
+					resource.TestCheckResourceAttrWith("qbee_ntp.test", "last_commit_sha", func(value string) error {
+						if value != lastCommitSHA {
+							return fmt.Errorf("last_commit_sha changed from %s to %s", lastCommitSHA, value)
+						}
+						return nil
+					}),
+				),
+			},
+			// Import tag
+			{
+				ResourceName:                         "qbee_ntp.test",
+				ImportState:                          true,
+				ImportStateId:                        "tag:terraform:acctest:ntp",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
+			},
+			// Update to be for a node
+			{
+				Config: providerConfig + `
+resource "qbee_ntp" "test" {
+  node = "integrationtests"
+  extend = true
+  servers = ["0.pool.ntp.org"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("qbee_ntp.test", "tag"),
+					resource.TestCheckResourceAttr("qbee_ntp.test", "node", "integrationtests"),
+					resource.TestCheckResourceAttr("qbee_ntp.test", "servers.#", "1"),
+				),
+			},
+			// Import node
+			{
+				ResourceName:                         "qbee_ntp.test",
+				ImportState:                          true,
+				ImportStateId:                        "node:integrationtests",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "node",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
+			},
+		},
+	})
+}
+
+func TestTimeZoneValidator(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringValue("Europe/Oslo")},
+		{value: types.StringValue("UTC")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("Europe/Atlantis"), wantErr: true},
+		{value: types.StringValue("CEST+2"), wantErr: true},
+		{value: types.StringValue("Local"), wantErr: true},
+		{value: types.StringValue(""), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("time_zone"), ConfigValue: tt.value}
+			var resp validator.StringResponse
+
+			timeZoneValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("ValidateString() error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// defaultMaxConcurrency is the default number of changes that are sent to the Qbee API concurrently.
+const defaultMaxConcurrency = 4
+
+// operationLimiter serialises conflicting changes to the Qbee API and bounds the number of concurrent ones.
+//
+// Each change locks the keys of what it modifies, like the path of a file manager entry or a device.
+// Keys are hierarchical: a key conflicts with itself and with the keys below it, so that deleting the
+// file manager directory "file:/dir" waits for uploads to "file:/dir/file.txt" and the other way round.
+// Changes with conflicting keys run one at a time, other changes run in parallel up to the maximum
+// concurrency. All configuration commits share commitLockKey, see CommitConfiguration.
+type operationLimiter struct {
+	// slots holds a token for each change in progress.
+	slots chan struct{}
+
+	mu   sync.Mutex
+	held map[string]struct{}
+
+	// released is closed and replaced whenever keys are released, to wake up the changes waiting for them.
+	released chan struct{}
+}
+
+// newOperationLimiter creates an operationLimiter running at most maxConcurrency changes at the same time.
+func newOperationLimiter(maxConcurrency int) *operationLimiter {
+	return &operationLimiter{
+		slots:    make(chan struct{}, max(maxConcurrency, 1)),
+		held:     make(map[string]struct{}),
+		released: make(chan struct{}),
+	}
+}
+
+// run executes fn once it holds the locks of all given keys and a free slot.
+// It returns the context error without executing fn if the context is done before.
+func (l *operationLimiter) run(ctx context.Context, keys []string, fn func() error) error {
+	keys = slices.Clone(keys)
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	if err := l.lock(ctx, keys); err != nil {
+		return err
+	}
+	defer l.unlock(keys)
+
+	// Slots are taken after the locks, so that changes waiting for a lock don't block unrelated changes
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-l.slots }()
+
+	return fn()
+}
+
+// lock acquires the locks of all given keys at once, waiting until none of them conflicts with a held key
+// or the context is done. Taking all keys at once prevents deadlocks between changes with overlapping keys.
+func (l *operationLimiter) lock(ctx context.Context, keys []string) error {
+	for {
+		l.mu.Lock()
+		if !l.conflicts(keys) {
+			for _, key := range keys {
+				l.held[key] = struct{}{}
+			}
+			l.mu.Unlock()
+
+			return nil
+		}
+		released := l.released
+		l.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// unlock releases the locks of the given keys.
+func (l *operationLimiter) unlock(keys []string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, key := range keys {
+		delete(l.held, key)
+	}
+
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// conflicts reports whether any of the keys conflicts with a held key. The caller must hold l.mu.
+func (l *operationLimiter) conflicts(keys []string) bool {
+	for _, key := range keys {
+		for heldKey := range l.held {
+			if key == heldKey || isBelowKey(key, heldKey) || isBelowKey(heldKey, key) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isBelowKey reports whether key is below the parent key in the key hierarchy.
+func isBelowKey(key, parent string) bool {
+	return strings.HasPrefix(key, strings.TrimSuffix(parent, "/")+"/")
+}
+
+// commitLockKey is the lock key of configuration commits. A commit in qbee takes all uncommitted changes of
+// the user, not only the changes of one node or tag, so staging and committing changes must never overlap.
+const commitLockKey = "commit"
+
+// fileLockKey returns the lock key of a file manager path.
+func fileLockKey(filePath string) string {
+	return "file:" + path.Clean("/"+filePath)
+}
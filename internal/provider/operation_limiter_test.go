@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.qbee.io/client"
+)
+
+// racyStore simulates an API applying changes with a non-atomic read-modify-write per key,
+// which loses updates when changes to the same key overlap.
+type racyStore struct {
+	mu     sync.Mutex
+	values map[string]int
+}
+
+func (s *racyStore) increment(key string) {
+	s.mu.Lock()
+	value := s.values[key]
+	s.mu.Unlock()
+
+	time.Sleep(100 * time.Microsecond)
+
+	s.mu.Lock()
+	s.values[key] = value + 1
+	s.mu.Unlock()
+}
+
+func TestOperationLimiterNoLostUpdates(t *testing.T) {
+	const keys, changesPerKey = 5, 40
+
+	limiter := newOperationLimiter(8)
+	store := &racyStore{values: make(map[string]int)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < keys*changesPerKey; i++ {
+		key := fmt.Sprintf("tag:%d", i%keys)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := limiter.run(t.Context(), []string{key}, func() error {
+				store.increment(key)
+				return nil
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for i := 0; i < keys; i++ {
+		key := fmt.Sprintf("tag:%d", i)
+		if store.values[key] != changesPerKey {
+			t.Errorf("%s: got %d updates, want %d", key, store.values[key], changesPerKey)
+		}
+	}
+
+	if len(limiter.held) != 0 {
+		t.Errorf("got %d remaining locks, want none", len(limiter.held))
+	}
+}
+
+func TestOperationLimiterBoundsConcurrency(t *testing.T) {
+	const maxConcurrency = 3
+
+	limiter := newOperationLimiter(maxConcurrency)
+
+	var running, maxRunning atomic.Int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_ = limiter.run(t.Context(), []string{fmt.Sprintf("node:%d", i)}, func() error {
+				current := running.Add(1)
+				defer running.Add(-1)
+
+				for {
+					observed := maxRunning.Load()
+					if current <= observed || maxRunning.CompareAndSwap(observed, current) {
+						break
+					}
+				}
+
+				time.Sleep(5 * time.Millisecond)
+				return nil
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	if got := maxRunning.Load(); got > maxConcurrency {
+		t.Errorf("got %d concurrent changes, want at most %d", got, maxConcurrency)
+	}
+}
+
+func TestOperationLimiterOverlappingKeys(t *testing.T) {
+	limiter := newOperationLimiter(4)
+	store := &racyStore{values: make(map[string]int)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		keys := []string{"tag:a", "tag:b"}
+		if i%2 == 1 {
+			keys = []string{"tag:b", "tag:a"}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_ = limiter.run(t.Context(), keys, func() error {
+				store.increment("a+b")
+				return nil
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	if store.values["a+b"] != 50 {
+		t.Errorf("got %d updates, want 50", store.values["a+b"])
+	}
+}
+
+func TestOperationLimiterContextCancelled(t *testing.T) {
+	limiter := newOperationLimiter(1)
+
+	held := make(chan struct{})
+	release := make(chan struct{})
+
+	go func() {
+		_ = limiter.run(t.Context(), []string{"tag:a"}, func() error {
+			close(held)
+			<-release
+			return nil
+		})
+	}()
+
+	<-held
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	err := limiter.run(ctx, []string{"tag:a"}, func() error {
+		t.Error("change must not run while the key is locked")
+		return nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(release)
+}
+
+func TestFileLockKey(t *testing.T) {
+	if fileLockKey("dir//file.txt") != fileLockKey("/dir/file.txt") {
+		t.Errorf("expected equivalent file paths to share a lock key")
+	}
+}
+
+func TestOperationLimiterNestedKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		held     string
+		key      string
+		conflict bool
+	}{
+		{name: "same path", held: fileLockKey("/dir"), key: fileLockKey("/dir"), conflict: true},
+		{name: "file in deleted directory", held: fileLockKey("/dir"), key: fileLockKey("/dir/file.txt"), conflict: true},
+		{name: "directory of uploaded file", held: fileLockKey("/dir/sub/file.txt"), key: fileLockKey("/dir"), conflict: true},
+		{name: "root directory", held: fileLockKey("/"), key: fileLockKey("/dir/file.txt"), conflict: true},
+		{name: "sibling with common prefix", held: fileLockKey("/dir"), key: fileLockKey("/directory/file.txt")},
+		{name: "other entity", held: fileLockKey("/dir"), key: "node:dir"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newOperationLimiter(2)
+
+			locked := make(chan struct{})
+			release := make(chan struct{})
+			go limiter.run(t.Context(), []string{tt.held}, func() error {
+				close(locked)
+				<-release
+				return nil
+			})
+			<-locked
+			defer close(release)
+
+			ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+			defer cancel()
+
+			err := limiter.run(ctx, []string{tt.key}, func() error { return nil })
+			if conflict := errors.Is(err, context.DeadlineExceeded); conflict != tt.conflict {
+				t.Errorf("got conflict %t (error %v), want %t", conflict, err, tt.conflict)
+			}
+		})
+	}
+}
+
+func TestCommitConfigurationSerialisesAllCommits(t *testing.T) {
+	qbeeClient := NewClient(http.DefaultClient, "http://localhost")
+
+	locked := make(chan struct{})
+	release := make(chan struct{})
+
+	// Hold the commit lock as a commit to another node would
+	go qbeeClient.limiter.run(t.Context(), []string{commitLockKey}, func() error {
+		close(locked)
+		<-release
+		return nil
+	})
+	<-locked
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := qbeeClient.CommitConfiguration(ctx, "message", client.ChangeRequest{NodeID: "device-2"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want the commit to wait for the other commit", err)
+	}
+}
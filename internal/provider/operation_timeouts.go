@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultOperationTimeout is the timeout of resource operations if neither the provider nor the resource set one.
+const defaultOperationTimeout = 20 * time.Minute
+
+// operationTimeouts defines the timeouts of the create, read, update and delete operations of a resource.
+type operationTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// providerTimeoutsModel describes the default_timeouts provider attribute.
+type providerTimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// providerTimeoutsAttribute returns the schema of the default_timeouts provider attribute.
+func providerTimeoutsAttribute() schema.SingleNestedAttribute {
+	attributes := make(map[string]schema.Attribute)
+
+	for _, operation := range []string{"create", "read", "update", "delete"} {
+		attributes[operation] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Default timeout of %s operations. A duration string such as `30s` or "+
+				"`2h45m`. Defaults to `20m`.", operation),
+			Optional: true,
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Default timeouts of resource operations. Each resource can override them using its own " +
+			"`timeouts` attribute. When a timeout expires, the pending API call is cancelled and the operation fails.",
+		Optional:   true,
+		Attributes: attributes,
+	}
+}
+
+// operationTimeouts returns the configured default timeouts, falling back to defaultOperationTimeout.
+func (m *providerTimeoutsModel) operationTimeouts() (operationTimeouts, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := operationTimeouts{
+		Create: defaultOperationTimeout,
+		Read:   defaultOperationTimeout,
+		Update: defaultOperationTimeout,
+		Delete: defaultOperationTimeout,
+	}
+
+	if m == nil {
+		return result, diags
+	}
+
+	for _, operation := range []struct {
+		name   string
+		value  types.String
+		target *time.Duration
+	}{
+		{"create", m.Create, &result.Create},
+		{"read", m.Read, &result.Read},
+		{"update", m.Update, &result.Update},
+		{"delete", m.Delete, &result.Delete},
+	} {
+		if operation.value.IsNull() || operation.value.IsUnknown() {
+			continue
+		}
+
+		timeout, err := time.ParseDuration(operation.value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("default_timeouts").AtName(operation.name), "Invalid default timeout",
+				fmt.Sprintf("unable to parse the %s timeout %q: %v", operation.name, operation.value.ValueString(), err))
+			continue
+		}
+
+		*operation.target = timeout
+	}
+
+	return result, diags
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProviderTimeoutsModelOperationTimeouts(t *testing.T) {
+	tests := []struct {
+		name    string
+		model   *providerTimeoutsModel
+		want    operationTimeouts
+		wantErr bool
+	}{
+		{
+			name: "unset",
+			want: operationTimeouts{
+				Create: defaultOperationTimeout,
+				Read:   defaultOperationTimeout,
+				Update: defaultOperationTimeout,
+				Delete: defaultOperationTimeout,
+			},
+		},
+		{
+			name: "partially set",
+			model: &providerTimeoutsModel{
+				Create: types.StringValue("10m"),
+				Read:   types.StringNull(),
+				Update: types.StringNull(),
+				Delete: types.StringValue("90s"),
+			},
+			want: operationTimeouts{
+				Create: 10 * time.Minute,
+				Read:   defaultOperationTimeout,
+				Update: defaultOperationTimeout,
+				Delete: 90 * time.Second,
+			},
+		},
+		{
+			name: "invalid duration",
+			model: &providerTimeoutsModel{
+				Create: types.StringValue("ten minutes"),
+				Read:   types.StringNull(),
+				Update: types.StringNull(),
+				Delete: types.StringNull(),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := tt.model.operationTimeouts()
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("got diagnostics %v, want error %v", diags, tt.wantErr)
+			}
+
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -53,7 +53,7 @@ func (r *packageManagementResource) ConfigValidators(ctx context.Context) []reso
 }
 
 // Schema defines the schema for the resource.
-func (r *packageManagementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *packageManagementResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "PackageManagement controls system packages.",
 		Attributes: map[string]schema.Attribute{
@@ -72,6 +72,7 @@ func (r *packageManagementResource) Schema(_ context.Context, _ resource.SchemaR
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"pre_condition": schema.StringAttribute{
 				Optional:    true,
 				Description: "If set, will be executed before package maintenance. If the command returns a non-zero exit code, the package maintenance will be skipped.",
@@ -105,8 +106,15 @@ func (r *packageManagementResource) Schema(_ context.Context, _ resource.SchemaR
 					},
 				},
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type packageManagementResourceModel struct {
@@ -134,6 +142,7 @@ func (m *packageManagementResourceModel) fromBundleData(bundleData config.Bundle
 	}
 
 	m.Extend = types.BoolValue(data.Extend)
+	m.Enabled = types.BoolValue(data.Enabled)
 
 	m.RebootMode = types.StringValue(string(data.RebootMode))
 
@@ -63,6 +63,7 @@ resource "qbee_package_management" "test" {
 				ImportStateId:                        "tag:terraform:acctest:packagemanagement",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 			// Update to be for a node
 			{
@@ -96,6 +97,7 @@ resource "qbee_package_management" "test" {
 				ImportStateId:                        "node:integrationtests",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "node",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 		},
 	})
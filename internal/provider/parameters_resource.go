@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.qbee.io/client"
@@ -80,6 +81,39 @@ type parametersResourceModel struct {
 	SecretsHash types.String `tfsdk:"secrets_hash"`
 }
 
+// fromBundleData updates the model from the parameters bundle data.
+func (m *parametersResourceModel) fromBundleData(bundleData config.BundleData) error {
+	data := bundleData.Parameters
+	if data == nil {
+		return fmt.Errorf("parameters bundle data is nil")
+	}
+
+	m.Extend = types.BoolValue(data.Extend)
+	m.Enabled = types.BoolValue(data.Enabled)
+
+	// Parameters can be mapped directly
+	if len(data.Parameters) > 0 {
+		mappedParameters := make([]parameter, len(data.Parameters))
+		for i, p := range data.Parameters {
+			mappedParameters[i] = parameter{
+				Key:   types.StringValue(p.Key),
+				Value: types.StringValue(p.Value),
+			}
+		}
+		m.Parameters = mappedParameters
+	}
+
+	// Secrets are not read themselves, but we decide the SecretsHash from it
+	if len(data.Secrets) == 0 {
+		// We can safely determine that the SecretsWoValuesHash should be null if there currently are no secrets.
+		m.SecretsHash = types.StringNull()
+	} else {
+		m.SecretsHash = types.StringValue(computeSecretsHash(data.Secrets))
+	}
+
+	return nil
+}
+
 type parameter struct {
 	Key   types.String `tfsdk:"key"`
 	Value types.String `tfsdk:"value"`
@@ -91,7 +125,7 @@ type secret struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *parametersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *parametersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Parameters sets global configuration parameters.",
 		Attributes: map[string]schema.Attribute{
@@ -110,6 +144,7 @@ func (r *parametersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"parameters": schema.ListNestedAttribute{
 				Optional:    true,
 				Description: "Parameters is a list of key/value pairs",
@@ -153,8 +188,15 @@ func (r *parametersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Description: "A computed hash based on secret IDs from qbee. This value changes when secrets are updated and is used to detect drift in remote secret values.",
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes, "secrets_wo_version")
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 func (r *parametersResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -168,6 +210,10 @@ func (r *parametersResource) ConfigValidators(ctx context.Context) []resource.Co
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *parametersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.checkReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the plan.
 	// Combine plan and configuration, since write-only values are not in the plan.
 	var effective parametersResourceModel
@@ -187,6 +233,15 @@ func (r *parametersResource) Create(ctx context.Context, req resource.CreateRequ
 	effective.SecretsWo = configuration.SecretsWo
 	effective.SecretsWoVersion = configuration.SecretsWoVersion
 
+	createTimeout, diags := effective.Timeouts.Create(ctx, r.client.defaultTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	private, diags := r.writeParameters(ctx, &effective, privateStateModel{})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -203,11 +258,20 @@ func (r *parametersResource) Create(ctx context.Context, req resource.CreateRequ
 	// Set state to fully populated data
 	var state parametersResourceModel
 	state.Extend = effective.Extend
+	state.Enabled = effective.Enabled
 	state.Node = effective.Node
 	state.Tag = effective.Tag
 	state.Parameters = effective.Parameters
 	state.SecretsHash = effective.SecretsHash
 	state.SecretsWoVersion = effective.SecretsWoVersion
+	state.Timeouts = effective.Timeouts
+	state.CommitMessage = effective.CommitMessage
+	state.ReadEffective = effective.ReadEffective
+	state.LastCommitSHA = effective.LastCommitSHA
+	state.LastCommitCreated = effective.LastCommitCreated
+
+	state.Effective, diags = r.readEffectiveParameters(ctx, resp.State, &state)
+	resp.Diagnostics.Append(warnOnError(diags)...)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -218,6 +282,15 @@ func (r *parametersResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *parametersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
+	if onlyCommitMessageChanged(req.Plan, req.State) {
+		keepConfiguration(ctx, req, resp)
+		return
+	}
+
 	// Retrieve values from the plan.
 	// Combine plan and configuration, since write-only values are not in the plan.
 	var plan parametersResourceModel
@@ -238,6 +311,15 @@ func (r *parametersResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Only copy SecretsWo from configuration. Keep SecretsWoVersion from the plan.
 	effective.SecretsWo = configuration.SecretsWo
 
+	updateTimeout, diags := effective.Timeouts.Update(ctx, r.client.defaultTimeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	privateStateBytes, diags := req.Private.GetKey(ctx, privateStateKey)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -266,11 +348,20 @@ func (r *parametersResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Set state to fully populated data
 	var state parametersResourceModel
 	state.Extend = effective.Extend
+	state.Enabled = effective.Enabled
 	state.Node = effective.Node
 	state.Tag = effective.Tag
 	state.Parameters = effective.Parameters
 	state.SecretsWoVersion = effective.SecretsWoVersion
 	state.SecretsHash = effective.SecretsHash
+	state.Timeouts = effective.Timeouts
+	state.CommitMessage = effective.CommitMessage
+	state.ReadEffective = effective.ReadEffective
+	state.LastCommitSHA = effective.LastCommitSHA
+	state.LastCommitCreated = effective.LastCommitCreated
+
+	state.Effective, diags = r.readEffectiveParameters(ctx, resp.State, &state)
+	resp.Diagnostics.Append(warnOnError(diags)...)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -289,6 +380,15 @@ func (r *parametersResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.defaultTimeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	configType, identifier := state.getEntityType(), state.getEntityID()
 
 	// Read the real status
@@ -301,34 +401,19 @@ func (r *parametersResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Update the current state
-	currentParameters := activeConfig.BundleData.Parameters
-	if currentParameters == nil {
+	if activeConfig.BundleData.Parameters == nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	state.Extend = types.BoolValue(currentParameters.Extend)
-
-	// Parameters can be mapped directly
-	if len(currentParameters.Parameters) > 0 {
-		mappedParameters := make([]parameter, len(currentParameters.Parameters))
-		for i, p := range currentParameters.Parameters {
-			mappedParameters[i] = parameter{
-				Key:   types.StringValue(p.Key),
-				Value: types.StringValue(p.Value),
-			}
-		}
-		state.Parameters = mappedParameters
+	if err := state.fromBundleData(activeConfig.BundleData); err != nil {
+		resp.Diagnostics.AddError(errorReadingParameters, err.Error())
+		return
 	}
 
-	// Secrets are not read themselves, but we decide the SecretsHash from it
-	if len(currentParameters.Secrets) == 0 {
-		// We can safely determine that the SecretsWoValuesHash should be null if there currently are no secrets.
-		state.SecretsHash = types.StringNull()
-	} else {
-		currentHash := computeSecretsHash(currentParameters.Secrets)
-		state.SecretsHash = types.StringValue(currentHash)
-	}
+	// The effective configuration is informational, so failing to read it must not fail the refresh
+	state.Effective, diags = r.readEffectiveParameters(ctx, resp.State, state)
+	resp.Diagnostics.Append(warnOnError(diags)...)
 
 	// Write the state
 	diags = resp.State.Set(ctx, state)
@@ -338,6 +423,55 @@ func (r *parametersResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 }
 
+// readEffectiveParameters reads the parameters of the node or tag of the model merged with the parameters
+// of its parent nodes. Secrets are left out, as their values are not returned by the API.
+func (r *parametersResource) readEffectiveParameters(
+	ctx context.Context,
+	state tfsdk.State,
+	model *parametersResourceModel,
+) (types.Object, diag.Diagnostics) {
+	effectiveType, diags := effectiveObjectType(ctx, state)
+	if diags.HasError() {
+		return types.ObjectNull(nil), diags
+	}
+
+	if !model.ReadEffective.ValueBool() {
+		return types.ObjectNull(effectiveType.AttrTypes), diags
+	}
+
+	activeConfig, err := r.client.GetActiveConfig(ctx, model.getEntityType(), model.getEntityID(), config.EntityConfigScopeAll)
+	if err != nil {
+		diags.AddError(errorReadingParameters,
+			"error reading the effective configuration: "+err.Error())
+
+		return types.ObjectNull(effectiveType.AttrTypes), diags
+	}
+
+	effectiveParameters := activeConfig.BundleData.Parameters
+	if effectiveParameters == nil {
+		return types.ObjectNull(effectiveType.AttrTypes), diags
+	}
+
+	effective := struct {
+		Enabled    types.Bool  `tfsdk:"enabled"`
+		Parameters []parameter `tfsdk:"parameters"`
+	}{
+		Enabled: types.BoolValue(effectiveParameters.Enabled),
+	}
+
+	for _, p := range effectiveParameters.Parameters {
+		effective.Parameters = append(effective.Parameters, parameter{
+			Key:   types.StringValue(p.Key),
+			Value: types.StringValue(p.Value),
+		})
+	}
+
+	value, valueDiags := types.ObjectValueFrom(ctx, effectiveType.AttrTypes, effective)
+	diags.Append(valueDiags...)
+
+	return value, diags
+}
+
 func (r *parametersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// If either Plan or State is null, nothing to do (no resource instance)
 	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
@@ -424,12 +558,23 @@ func (r *parametersResource) ModifyPlan(ctx context.Context, req resource.Modify
 		plan.SecretsHash = types.StringNull()
 	}
 
+	// Updating the secrets creates a new commit
+	if plan.SecretsHash.IsUnknown() {
+		plan.LastCommitSHA = types.StringUnknown()
+		plan.LastCommitCreated = types.Int64Unknown()
+		plan.Effective = types.ObjectUnknown(plan.Effective.AttributeTypes(ctx))
+	}
+
 	diags = resp.Plan.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *parametersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from the state
 	var state parametersResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -438,6 +583,15 @@ func (r *parametersResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.defaultTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the resource
 	configType, identifier := state.getEntityType(), state.getEntityID()
 	tflog.Info(ctx, fmt.Sprintf("Deleting parameters for %v %v", configType, identifier))
@@ -501,6 +655,7 @@ func (r *parametersResource) ImportState(ctx context.Context, req resource.Impor
 func (r *parametersResource) writeParameters(ctx context.Context, effective *parametersResourceModel, privateState privateStateModel) ([]byte, diag.Diagnostics) {
 	configType, identifier := effective.getEntityType(), effective.getEntityID()
 	extend := effective.Extend.ValueBool()
+	enabled := effective.Enabled.ValueBool()
 
 	// Create the resource
 	tflog.Info(ctx, fmt.Sprintf("Creating parameters for %v %v", configType, identifier))
@@ -551,7 +706,7 @@ func (r *parametersResource) writeParameters(ctx context.Context, effective *par
 
 	content := config.Parameters{
 		Metadata: config.Metadata{
-			Enabled: true,
+			Enabled: enabled,
 			Extend:  extend,
 			Version: "v1",
 		},
@@ -578,7 +733,12 @@ func (r *parametersResource) writeParameters(ctx context.Context, effective *par
 		}
 	}
 
-	commit, err := r.client.CommitConfiguration(ctx, "terraform: create parameters_resource", changeRequest)
+	message := "terraform: create parameters_resource"
+	if effective.CommitMessage.ValueString() != "" {
+		message = effective.CommitMessage.ValueString()
+	}
+
+	commit, err := r.client.CommitConfiguration(ctx, message, changeRequest)
 	if err != nil {
 		diags := diag.Diagnostics{}
 
@@ -588,6 +748,8 @@ func (r *parametersResource) writeParameters(ctx context.Context, effective *par
 		return nil, diags
 	}
 
+	effective.setLastCommit(commit)
+
 	if len(secretsToWrite) == 0 {
 		effective.SecretsHash = types.StringNull()
 		return nil, nil
@@ -88,6 +88,8 @@ func TestAccParametersResource(t *testing.T) {
 				ImportStateVerifyIdentifierAttribute: "tag",
 				ImportStateVerifyIgnore: []string{
 					"secrets",
+					"last_commit_sha",
+					"last_commit_created",
 				},
 			},
 			// Change to only have secrets
@@ -216,6 +218,8 @@ resource "qbee_parameters" "test" {
 				ImportStateVerifyIdentifierAttribute: "node",
 				ImportStateVerifyIgnore: []string{
 					"secrets_hash",
+					"last_commit_sha",
+					"last_commit_created",
 				},
 			},
 		},
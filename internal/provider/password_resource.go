@@ -38,7 +38,7 @@ type passwordResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *passwordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *passwordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Password bundle sets passwords for existing users.",
 		Attributes: map[string]schema.Attribute{
@@ -57,6 +57,7 @@ func (r *passwordResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"users": schema.ListNestedAttribute{
 				Required:    true,
 				Description: "A list of users and their password hashes.",
@@ -74,8 +75,15 @@ func (r *passwordResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					},
 				},
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type passwordResourceModel struct {
@@ -99,6 +107,7 @@ func (m *passwordResourceModel) fromBundleData(bundleData config.BundleData) err
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	for _, user := range data.Users {
 		m.Users = append(m.Users, userPassword{
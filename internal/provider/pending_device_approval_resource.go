@@ -0,0 +1,506 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.qbee.io/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &pendingDeviceApprovalResource{}
+	_ resource.ResourceWithConfigure        = &pendingDeviceApprovalResource{}
+	_ resource.ResourceWithConfigValidators = &pendingDeviceApprovalResource{}
+	_ resource.ResourceWithValidateConfig   = &pendingDeviceApprovalResource{}
+	_ resource.ResourceWithImportState      = &pendingDeviceApprovalResource{}
+)
+
+const (
+	errorApprovingDevice = "error approving pending device"
+	errorReadingDevice   = "error reading approved device"
+	errorMovingDevice    = "error moving approved device"
+	errorRemovingDevice  = "error removing approved device"
+
+	// incompleteApprovalKey is the private state key marking a device that was approved, but not moved to
+	// its group. Such a device is kept when the resource is destroyed, as it was not approved by Terraform
+	// into the configured group yet.
+	incompleteApprovalKey = "incomplete_approval"
+)
+
+// NewPendingDeviceApprovalResource is a helper function to simplify the provider implementation.
+func NewPendingDeviceApprovalResource() resource.Resource {
+	return &pendingDeviceApprovalResource{
+		resourceBase: newResourceBase("pending_device_approval"),
+	}
+}
+
+type pendingDeviceApprovalResource struct {
+	resourceBase
+}
+
+type pendingDeviceApprovalResourceModel struct {
+	NodeID          types.String   `tfsdk:"node_id"`
+	PubKeyDigest    types.String   `tfsdk:"pub_key_digest"`
+	Approve         types.Bool     `tfsdk:"approve"`
+	GroupID         types.String   `tfsdk:"group_id"`
+	RemoveOnDestroy types.Bool     `tfsdk:"remove_on_destroy"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Schema defines the schema for the resource.
+func (r *pendingDeviceApprovalResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Pending device approval accepts or rejects a device that bootstrapped with a bootstrap key " +
+			"without auto accept. The device must be pending approval when the resource is created. A device that " +
+			"is already approved, like one approved by an earlier apply that failed to move it to its group, is " +
+			"adopted instead of approved again.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The node ID of the device. Either node_id or pub_key_digest is required.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pub_key_digest": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The fingerprint of the public key of the device. Either node_id or pub_key_digest is required.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"approve": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       booldefault.StaticBool(true),
+				Description:   "If the device is approved (true) or rejected (false). Defaults to true.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"group_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "The ID of the group the approved device is moved to. If not set, the device is kept " +
+					"in the group it was placed in when it was approved.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"remove_on_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "If the approved device is removed from qbee when the resource is destroyed. The device " +
+					"then has to bootstrap again to reconnect. A device that bootstrapped again and is pending approval " +
+					"is rejected instead. A device that was approved, but not moved to its group, is kept. Defaults to " +
+					"false, which keeps the device.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *pendingDeviceApprovalResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("node_id"),
+			path.MatchRoot("pub_key_digest"),
+		),
+	}
+}
+
+// ValidateConfig rejects a target group for devices that are rejected.
+func (r *pendingDeviceApprovalResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config pendingDeviceApprovalResourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Approve.IsNull() || config.Approve.IsUnknown() || config.Approve.ValueBool() {
+		return
+	}
+
+	if !config.GroupID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("group_id"), "Invalid group_id",
+			"A group can only be set for devices that are approved.")
+	}
+
+	if config.RemoveOnDestroy.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("remove_on_destroy"), "Invalid remove_on_destroy",
+			"Only approved devices can be removed on destroy.")
+	}
+}
+
+// Create approves or rejects the pending device and sets the initial Terraform state.
+func (r *pendingDeviceApprovalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.checkReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
+	var plan pendingDeviceApprovalResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.defaultTimeouts.Create)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	pendingDevices, err := r.client.listPendingDevices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorApprovingDevice,
+			"error listing the pending devices: "+err.Error())
+		return
+	}
+
+	device := findPendingDevice(pendingDevices, plan.NodeID.ValueString(), plan.PubKeyDigest.ValueString())
+
+	// A device approved by an earlier apply that failed to move it to its group is no longer pending approval,
+	// for example when the tainted resource is replaced. It is adopted, so that moving it is retried.
+	approved := false
+	if device == nil && plan.Approve.ValueBool() {
+		approvedDevice, err := r.findApprovedDevice(ctx, plan.NodeID.ValueString(), plan.PubKeyDigest.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(errorApprovingDevice,
+				"error looking up the approved devices: "+err.Error())
+			return
+		}
+
+		if approvedDevice != nil {
+			device = &pendingDevice{NodeID: approvedDevice.NodeID, PubKeyDigest: approvedDevice.PubKeyDigest}
+			approved = true
+		}
+	}
+
+	if device == nil {
+		resp.Diagnostics.AddError(errorApprovingDevice,
+			fmt.Sprintf("no device with node ID %q or public key digest %q is pending approval",
+				plan.NodeID.ValueString(), plan.PubKeyDigest.ValueString()))
+		return
+	}
+
+	plan.NodeID = types.StringValue(device.NodeID)
+	plan.PubKeyDigest = types.StringValue(device.PubKeyDigest)
+
+	if !plan.Approve.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("Rejecting pending device %v", device.NodeID))
+
+		if err := r.client.rejectDevice(ctx, device.NodeID); err != nil {
+			resp.Diagnostics.AddError(errorApprovingDevice,
+				"error rejecting the pending device: "+err.Error())
+			return
+		}
+
+		plan.GroupID = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	if approved {
+		tflog.Info(ctx, fmt.Sprintf("Device %v is already approved, adopting it", device.NodeID))
+	} else {
+		tflog.Info(ctx, fmt.Sprintf("Approving pending device %v", device.NodeID))
+
+		if err := r.client.approveDevice(ctx, device.NodeID); err != nil {
+			resp.Diagnostics.AddError(errorApprovingDevice,
+				"error approving the pending device: "+err.Error())
+			return
+		}
+	}
+
+	// The device is approved from here on, so keep it in the state even if moving it fails. It is marked as
+	// incomplete, so that replacing the tainted resource does not remove the device that was just approved.
+	groupID := plan.GroupID
+	plan.GroupID = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, incompleteApprovalKey, []byte("true"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !groupID.IsUnknown() {
+		if err := r.moveDevice(ctx, device.NodeID, groupID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(errorMovingDevice,
+				fmt.Sprintf("error moving the device to group %v: %v", groupID.ValueString(), err))
+			return
+		}
+
+		plan.GroupID = groupID
+	} else {
+		plan.GroupID, err = r.readDeviceGroup(ctx, device.NodeID)
+		if err != nil {
+			resp.Diagnostics.AddError(errorReadingDevice,
+				"error reading the group of the approved device: "+err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, incompleteApprovalKey, nil)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *pendingDeviceApprovalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pendingDeviceApprovalResourceModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.defaultTimeouts.Read)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Imported devices are approved devices
+	if state.Approve.IsNull() {
+		state.Approve = types.BoolValue(true)
+	}
+
+	if state.RemoveOnDestroy.IsNull() {
+		state.RemoveOnDestroy = types.BoolValue(false)
+	}
+
+	// Rejected devices are gone from qbee, so there is nothing to refresh
+	if !state.Approve.ValueBool() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
+	nodeID := state.NodeID.ValueString()
+
+	groupID, err := r.readDeviceGroup(ctx, nodeID)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The device was removed from qbee outside of Terraform
+			tflog.Info(ctx, fmt.Sprintf("Device %v not found, removing from state", nodeID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(errorReadingDevice,
+			"error reading the approved device: "+err.Error())
+		return
+	}
+
+	state.GroupID = groupID
+
+	// Imported devices only have a node ID
+	if state.PubKeyDigest.IsNull() || state.PubKeyDigest.IsUnknown() {
+		device, err := r.client.getDevice(ctx, nodeID)
+		if err != nil {
+			resp.Diagnostics.AddError(errorReadingDevice,
+				"error reading the public key digest of the approved device: "+err.Error())
+			return
+		}
+
+		state.PubKeyDigest = nullableStringValue(device.PubKeyDigest)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update moves the approved device to its new group and sets the updated Terraform state on success.
+func (r *pendingDeviceApprovalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
+	var state, plan pendingDeviceApprovalResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.defaultTimeouts.Update)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if !plan.GroupID.Equal(state.GroupID) {
+		if err := r.moveDevice(ctx, plan.NodeID.ValueString(), plan.GroupID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(errorMovingDevice,
+				fmt.Sprintf("error moving the device to group %v: %v", plan.GroupID.ValueString(), err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, incompleteApprovalKey, nil)...)
+}
+
+// Delete optionally removes the approved device, or rejects it if it is pending approval again, and removes
+// the Terraform state on success.
+func (r *pendingDeviceApprovalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pendingDeviceApprovalResourceModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without removal, the approval or rejection is simply forgotten
+	if !state.Approve.ValueBool() || !state.RemoveOnDestroy.ValueBool() {
+		return
+	}
+
+	nodeID := state.NodeID.ValueString()
+
+	incomplete, diags := req.Private.GetKey(ctx, incompleteApprovalKey)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(incomplete) != 0 {
+		tflog.Info(ctx, fmt.Sprintf("The approval of device %v did not complete, keeping the device", nodeID))
+		return
+	}
+
+	if r.checkReadOnly("delete", &resp.Diagnostics) {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.defaultTimeouts.Delete)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	pendingDevices, err := r.client.listPendingDevices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRemovingDevice,
+			"error listing the pending devices: "+err.Error())
+		return
+	}
+
+	// A device that was removed outside of Terraform and bootstrapped again is pending approval again
+	if findPendingDevice(pendingDevices, nodeID, "") != nil {
+		tflog.Info(ctx, fmt.Sprintf("Rejecting pending device %v", nodeID))
+
+		if err := r.client.rejectDevice(ctx, nodeID); err != nil {
+			resp.Diagnostics.AddError(errorRemovingDevice,
+				"error rejecting the pending device: "+err.Error())
+		}
+
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removing approved device %v", nodeID))
+
+	if err := r.client.removeDevice(ctx, nodeID); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(errorRemovingDevice,
+			"error removing the approved device: "+err.Error())
+	}
+}
+
+// ImportState imports an approved device by its node ID.
+func (r *pendingDeviceApprovalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("node_id"), req, resp)
+}
+
+// moveDevice moves the device with the given node ID to the group with the given ID.
+func (r *pendingDeviceApprovalResource) moveDevice(ctx context.Context, nodeID, groupID string) error {
+	tflog.Info(ctx, fmt.Sprintf("Moving device %v to group %v", nodeID, groupID))
+
+	return r.client.GroupTreeUpdate(ctx, client.GroupTreeRequest{
+		Changes: []client.GroupTreeChange{
+			{
+				Action: client.TreeActionMove,
+				Data: client.GroupTreeChangeData{
+					Type:     client.NodeTypeDevice,
+					NodeID:   nodeID,
+					ParentID: groupID,
+				},
+			},
+		},
+	})
+}
+
+// readDeviceGroup returns the ID of the group the device with the given node ID is placed in.
+func (r *pendingDeviceApprovalResource) readDeviceGroup(ctx context.Context, nodeID string) (types.String, error) {
+	node, err := r.client.GroupTreeGetNode(ctx, nodeID)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	// The ancestors of a node end with the node itself
+	if len(node.Ancestors) < 2 {
+		return types.StringNull(), nil
+	}
+
+	return types.StringValue(node.Ancestors[len(node.Ancestors)-2]), nil
+}
+
+// findApprovedDevice returns the approved device with the given node ID or public key digest, or nil if not found.
+func (r *pendingDeviceApprovalResource) findApprovedDevice(ctx context.Context, nodeID, pubKeyDigest string) (*device, error) {
+	if nodeID != "" {
+		device, err := r.client.getDevice(ctx, nodeID)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		return device, nil
+	}
+
+	// The inventory can't be searched by public key digest
+	devices, err := r.client.listDevices(ctx, deviceSearch{})
+	if err != nil {
+		return nil, err
+	}
+
+	return findDeviceByPubKeyDigest(devices, pubKeyDigest), nil
+}
+
+// findDeviceByPubKeyDigest returns the device with the given public key digest, or nil if not found.
+func findDeviceByPubKeyDigest(devices []device, pubKeyDigest string) *device {
+	for i, device := range devices {
+		if pubKeyDigest != "" && device.PubKeyDigest == pubKeyDigest {
+			return &devices[i]
+		}
+	}
+
+	return nil
+}
+
+// findPendingDevice returns the pending device with the given node ID or public key digest, or nil if not found.
+func findPendingDevice(devices []pendingDevice, nodeID, pubKeyDigest string) *pendingDevice {
+	for i, device := range devices {
+		if (nodeID != "" && device.NodeID == nodeID) || (pubKeyDigest != "" && device.PubKeyDigest == pubKeyDigest) {
+			return &devices[i]
+		}
+	}
+
+	return nil
+}
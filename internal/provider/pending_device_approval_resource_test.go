@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.qbee.io/client"
+)
+
+// TestAccPendingDeviceApprovalResource needs a device that is pending approval, whose node ID is
+// set in QBEE_PENDING_DEVICE_NODE_ID. The device is removed again at the end of the test.
+func TestAccPendingDeviceApprovalResource(t *testing.T) {
+	nodeID := os.Getenv("QBEE_PENDING_DEVICE_NODE_ID")
+	if nodeID == "" {
+		t.Skip("QBEE_PENDING_DEVICE_NODE_ID must be set to the node ID of a pending device")
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			// Approve the device into the root group
+			{
+				Config: providerConfig + `
+resource "qbee_pending_device_approval" "test" {
+  node_id           = "` + nodeID + `"
+  group_id          = "root"
+  remove_on_destroy = true
+}
+`,
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("qbee_pending_device_approval.test", "node_id", nodeID),
+					resourcetest.TestCheckResourceAttrSet("qbee_pending_device_approval.test", "pub_key_digest"),
+					resourcetest.TestCheckResourceAttr("qbee_pending_device_approval.test", "approve", "true"),
+					resourcetest.TestCheckResourceAttr("qbee_pending_device_approval.test", "group_id", "root"),
+				),
+			},
+			// Replacing the tainted resource adopts the device, which is no longer pending approval
+			{
+				Taint: []string{"qbee_pending_device_approval.test"},
+				Config: providerConfig + `
+resource "qbee_pending_device_approval" "test" {
+  node_id           = "` + nodeID + `"
+  group_id          = "root"
+  remove_on_destroy = true
+}
+`,
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("qbee_pending_device_approval.test", "node_id", nodeID),
+					resourcetest.TestCheckResourceAttr("qbee_pending_device_approval.test", "group_id", "root"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:                         "qbee_pending_device_approval.test",
+				ImportState:                          true,
+				ImportStateId:                        nodeID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "node_id",
+				ImportStateVerifyIgnore:              []string{"remove_on_destroy"},
+			},
+		},
+	})
+}
+
+func TestPendingDeviceApprovalValidateConfig(t *testing.T) {
+	r := NewPendingDeviceApprovalResource().(*pendingDeviceApprovalResource)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		name    string
+		config  pendingDeviceApprovalResourceModel
+		wantErr bool
+	}{
+		{
+			name:   "approved into a group",
+			config: pendingDeviceApprovalResourceModel{Approve: types.BoolValue(true), GroupID: types.StringValue("group")},
+		},
+		{
+			name:   "rejected",
+			config: pendingDeviceApprovalResourceModel{Approve: types.BoolValue(false)},
+		},
+		{
+			name:    "rejected into a group",
+			config:  pendingDeviceApprovalResourceModel{Approve: types.BoolValue(false), GroupID: types.StringValue("group")},
+			wantErr: true,
+		},
+		{
+			name:    "rejected and removed on destroy",
+			config:  pendingDeviceApprovalResourceModel{Approve: types.BoolValue(false), RemoveOnDestroy: types.BoolValue(true)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeoutsType := schemaResp.Schema.Attributes["timeouts"].GetType().(timeouts.Type)
+			tt.config.Timeouts = timeouts.Value{Object: types.ObjectNull(timeoutsType.AttrTypes)}
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(context.Background(), tt.config); diags.HasError() {
+				t.Fatalf("State.Set() diagnostics = %v", diags)
+			}
+
+			req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateConfig() diagnostics = %v, want error %v", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFindPendingDevice(t *testing.T) {
+	devices := []pendingDevice{
+		{NodeID: "node-1", PubKeyDigest: "digest-1"},
+		{NodeID: "node-2", PubKeyDigest: "digest-2"},
+	}
+
+	if device := findPendingDevice(devices, "node-2", ""); device == nil || device.PubKeyDigest != "digest-2" {
+		t.Errorf("findPendingDevice(node-2) = %v, want the second device", device)
+	}
+
+	if device := findPendingDevice(devices, "", "digest-1"); device == nil || device.NodeID != "node-1" {
+		t.Errorf("findPendingDevice(digest-1) = %v, want the first device", device)
+	}
+
+	if device := findPendingDevice(devices, "node-3", ""); device != nil {
+		t.Errorf("findPendingDevice(node-3) = %v, want nil", device)
+	}
+}
+
+func TestFindDeviceByPubKeyDigest(t *testing.T) {
+	devices := []device{
+		{NodeID: "node-1", PubKeyDigest: "digest-1"},
+		{NodeID: "node-2"},
+	}
+
+	if device := findDeviceByPubKeyDigest(devices, "digest-1"); device == nil || device.NodeID != "node-1" {
+		t.Errorf("findDeviceByPubKeyDigest(digest-1) = %v, want the first device", device)
+	}
+
+	if device := findDeviceByPubKeyDigest(devices, ""); device != nil {
+		t.Errorf("findDeviceByPubKeyDigest(\"\") = %v, want nil for devices without a digest", device)
+	}
+
+	if device := findDeviceByPubKeyDigest(devices, "digest-3"); device != nil {
+		t.Errorf("findDeviceByPubKeyDigest(digest-3) = %v, want nil", device)
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	if !isNotFoundError(client.Error{"error": map[string]any{"code": float64(404)}}) {
+		t.Error("isNotFoundError() = false for a 404 error")
+	}
+
+	if isNotFoundError(client.Error{"error": map[string]any{"code": float64(500)}}) {
+		t.Error("isNotFoundError() = true for a 500 error")
+	}
+}
@@ -41,7 +41,7 @@ type processWatchResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *processWatchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *processWatchResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "ProcessWatch ensures running process are running (or not).",
 		Attributes: map[string]schema.Attribute{
@@ -60,6 +60,7 @@ func (r *processWatchResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"processes": schema.ListNestedAttribute{
 				Required:    true,
 				Description: "Processes to watch.",
@@ -85,8 +86,15 @@ func (r *processWatchResource) Schema(_ context.Context, _ resource.SchemaReques
 					},
 				},
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type processWatchResourceModel struct {
@@ -111,6 +119,7 @@ func (m *processWatchResourceModel) fromBundleData(bundleData config.BundleData)
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	for _, process := range data.Processes {
 		m.Processes = append(m.Processes, processWatcher{
@@ -2,13 +2,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,9 +31,36 @@ type QbeeProvider struct {
 
 // qbeeProviderModel describes the provider data model.
 type qbeeProviderModel struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	BaseURL  types.String `tfsdk:"base_url"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	TOTPSecret types.String `tfsdk:"totp_secret"`
+	APIToken   types.String `tfsdk:"api_token"`
+	BaseURL    types.String `tfsdk:"base_url"`
+
+	Profile         types.String `tfsdk:"profile"`
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+
+	MaxRetries   types.Int64 `tfsdk:"max_retries"`
+	RetryWaitMin types.Int64 `tfsdk:"retry_wait_min"`
+	RetryWaitMax types.Int64 `tfsdk:"retry_wait_max"`
+
+	DefaultTimeouts *providerTimeoutsModel `tfsdk:"default_timeouts"`
+
+	ReadOnly types.Bool `tfsdk:"read_only"`
+
+	BatchCommits types.Bool  `tfsdk:"batch_commits"`
+	BatchWindow  types.Int64 `tfsdk:"batch_window"`
+
+	MaxConcurrency types.Int64 `tfsdk:"max_concurrency"`
 }
 
 func (p *QbeeProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -47,10 +80,133 @@ func (p *QbeeProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"totp_secret": schema.StringAttribute{
+				MarkdownDescription: "Base32 encoded secret of the authenticator app (TOTP) used for two-factor authentication. " +
+					"When set, the provider generates the one-time code itself to complete the login. " +
+					"Can also be set using the QBEE_TOTP_SECRET environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"api_token": schema.StringAttribute{
+				MarkdownDescription: "Qbee API token. When set, the provider uses the token to authenticate instead of " +
+					"logging in with `username` and `password`, which must then be left unset in the provider config. " +
+					"A token in the provider config takes precedence over a username and password in the environment. " +
+					"Can also be set using the QBEE_API_TOKEN environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "Qbee base URL. Defaults to `https://www.app.qbee.io`. Can also be set using the QBEE_BASE_URL environment variable.",
 				Optional:            true,
 			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the profile in the credentials file to read the credentials and base URL from. " +
+					"Defaults to the `default` profile, which is only used if it exists. Values set in the provider " +
+					"config take precedence over environment variables, which take precedence over the profile. " +
+					"The credentials and base URL of the profile are only used if no credentials are set in the provider " +
+					"config or environment, or the profile has no credentials. Can also be set using the QBEE_PROFILE environment variable.",
+				Optional: true,
+			},
+			"credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the credentials file containing the profiles. Defaults to " +
+					"`~/.config/qbee/credentials`, or `qbee/credentials` in `$XDG_CONFIG_HOME` when set. " +
+					"Can also be set using the QBEE_CREDENTIALS_FILE environment variable.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA bundle to trust in addition to the system roots. " +
+					"Can also be set using the QBEE_CA_CERT_FILE environment variable.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA bundle to trust in addition to the system roots. " +
+					"Can also be set using the QBEE_CA_CERT_PEM environment variable.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable verification of the Qbee API server certificate. Only use this for testing. " +
+					"Can also be set using the QBEE_INSECURE_SKIP_VERIFY environment variable.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy used to reach the Qbee API. Defaults to the standard " +
+					"`HTTPS_PROXY`/`NO_PROXY` environment variables. Can also be set using the QBEE_PROXY_URL environment variable.",
+				Optional: true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded client certificate for mutual TLS. Requires a client key. " +
+					"Can also be set using the QBEE_CLIENT_CERT_FILE environment variable.",
+				Optional: true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM encoded private key of the client certificate. " +
+					"Can also be set using the QBEE_CLIENT_KEY_FILE environment variable.",
+				Optional: true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS. Requires a client key. " +
+					"Can also be set using the QBEE_CLIENT_CERT_PEM environment variable.",
+				Optional: true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate. " +
+					"Can also be set using the QBEE_CLIENT_KEY_PEM environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of retries of a request failing with a transient error, "+
+					"like a 5xx or 429 response. Reads are retried on any transient error, changes only when the API "+
+					"did not process the request (429 and 503 responses). Set to 0 to disable retries. Defaults to `%d`. "+
+					"Can also be set using the QBEE_MAX_RETRIES environment variable.", defaultMaxRetries),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Backoff in seconds before the first retry, doubled for each following retry. "+
+					"Defaults to `%d`. Can also be set using the QBEE_RETRY_WAIT_MIN environment variable.",
+					int(defaultRetryWaitMin.Seconds())),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum backoff in seconds between retries, also applied to the "+
+					"`Retry-After` header sent by the API. Defaults to `%d`. Can also be set using the QBEE_RETRY_WAIT_MAX "+
+					"environment variable.", int(defaultRetryWaitMax.Seconds())),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"default_timeouts": providerTimeoutsAttribute(),
+			"batch_commits": schema.BoolAttribute{
+				MarkdownDescription: "Combine the configuration changes of an apply into a single commit, so that devices " +
+					"receive them as one atomic change. Changes are committed once no other change was made for " +
+					"`batch_window` seconds. Only the resources Terraform applies concurrently end up in the same " +
+					"commit, so increase `-parallelism` to batch more resources. If the commit fails, all resources " +
+					"of the batch report the error. Changes of `qbee_parameters` are always committed separately. " +
+					"Can also be set using the QBEE_BATCH_COMMITS environment variable.",
+				Optional: true,
+			},
+			"batch_window": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Seconds to wait for more configuration changes before committing "+
+					"a batch when `batch_commits` is enabled. Defaults to `%d`. Can also be set using the "+
+					"QBEE_BATCH_WINDOW environment variable.", int(defaultBatchWindow.Seconds())),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"max_concurrency": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of changes, like configuration commits and file uploads, "+
+					"that are sent to the Qbee API concurrently. Configuration commits, also to different nodes and tags, and "+
+					"changes to the same file manager path or device, are always made one at a time. Defaults to `%d`. Can also be set using the QBEE_MAX_CONCURRENCY "+
+					"environment variable.", defaultMaxConcurrency),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuse all changes, so that the provider can only be used to plan, refresh and " +
+					"import resources and to read data sources. Creating, updating or deleting a resource fails. " +
+					"Can also be set using the QBEE_READ_ONLY environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -82,50 +238,184 @@ func (p *QbeeProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	if config.TOTPSecret.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("totp_secret"),
+			"Unknown Qbee API TOTP Secret",
+			"The provider cannot create the Qbee API client as there is an unknown configuration value for the Qbee API TOTP secret. "+
+				"Either target apply the source of the value first, set the QBEE_TOTP_SECRET environment variable or set the value statically in the configuration.",
+		)
+	}
+
+	if config.APIToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Unknown Qbee API Token",
+			"The provider cannot create the Qbee API client as there is an unknown configuration value for the Qbee API token. "+
+				"Either target apply the source of the value first, set the QBEE_API_TOKEN environment variable or set the value statically in the configuration.",
+		)
+	}
+
+	if config.Profile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unknown Qbee API Profile",
+			"The provider cannot create the Qbee API client as there is an unknown configuration value for the Qbee API profile. "+
+				"Either target apply the source of the value first, set the QBEE_PROFILE environment variable or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	username := os.Getenv("QBEE_USERNAME")
-	password := os.Getenv("QBEE_PASSWORD")
 	baseUrl := os.Getenv("QBEE_BASE_URL")
+	if !config.BaseURL.IsNull() {
+		baseUrl = config.BaseURL.ValueString()
+	}
 
-	if !config.Username.IsNull() {
-		username = config.Username.ValueString()
+	configCredentials := credentialsProfile{
+		Username:   config.Username.ValueString(),
+		Password:   config.Password.ValueString(),
+		TOTPSecret: config.TOTPSecret.ValueString(),
+		APIToken:   config.APIToken.ValueString(),
 	}
 
-	if !config.Password.IsNull() {
-		password = config.Password.ValueString()
+	envCredentials := credentialsProfile{
+		Username:   os.Getenv("QBEE_USERNAME"),
+		Password:   os.Getenv("QBEE_PASSWORD"),
+		TOTPSecret: os.Getenv("QBEE_TOTP_SECRET"),
+		APIToken:   os.Getenv("QBEE_API_TOKEN"),
 	}
 
-	if !config.BaseURL.IsNull() {
-		baseUrl = config.BaseURL.ValueString()
+	// The credentials and base URL of the profile are only used when neither the provider config nor the
+	// environment set any credentials, see selectCredentials.
+	profile, err := loadCredentialsProfile(
+		stringValueOrEnv(config.CredentialsFile, "QBEE_CREDENTIALS_FILE"),
+		stringValueOrEnv(config.Profile, "QBEE_PROFILE"))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("profile"), "Invalid Qbee API profile",
+			"the Qbee API client can not be created because the profile could not be loaded: "+err.Error())
+		return
 	}
 
-	if username == "" {
-		resp.Diagnostics.AddAttributeError(path.Root("username"), "Missing Qbee API username",
-			"the Qbee API client can not be created because the username is missing."+
-				"Set the username in the provider config or use the QBEE_USERNAME environment variable.")
+	selected, err := selectCredentials(configCredentials, envCredentials, profile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("api_token"), "Conflicting Qbee API credentials",
+			"the Qbee API client can not be created because "+err.Error()+". "+
+				"Use either api_token (QBEE_API_TOKEN) or username and password (QBEE_USERNAME and QBEE_PASSWORD), not both. "+
+				"The TOTP secret (QBEE_TOTP_SECRET) can only be used together with username and password.")
+		return
 	}
 
-	if password == "" {
-		resp.Diagnostics.AddAttributeError(path.Root("password"), "Missing Qbee API password",
-			"the Qbee API client can not be created because the password is missing."+
-				"Set the password in the provider config or use the QBEE_PASSWORD environment variable.")
+	if baseUrl == "" {
+		baseUrl = selected.BaseURL
+	}
+
+	username, password, totpSecret, apiToken := selected.Username, selected.Password, selected.TOTPSecret, selected.APIToken
+
+	switch {
+	case apiToken == "" && username == "" && password == "":
+		resp.Diagnostics.AddError("Missing Qbee API credentials",
+			"the Qbee API client can not be created because no credentials are configured. "+
+				"Set api_token in the provider config or use the QBEE_API_TOKEN environment variable, "+
+				"or set username and password in the provider config or use the QBEE_USERNAME and QBEE_PASSWORD environment variables, "+
+				"or select a profile of the credentials file using the profile attribute or the QBEE_PROFILE environment variable.")
+	case apiToken == "":
+		if username == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("username"), "Missing Qbee API username",
+				"the Qbee API client can not be created because the username is missing. "+
+					"Set the username in the provider config or use the QBEE_USERNAME environment variable.")
+		}
+
+		if password == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("password"), "Missing Qbee API password",
+				"the Qbee API client can not be created because the password is missing. "+
+					"Set the password in the provider config or use the QBEE_PASSWORD environment variable.")
+		}
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	qbeeClient := NewClient()
+	insecureSkipVerify, err := boolValueOrEnv(config.InsecureSkipVerify, "QBEE_INSECURE_SKIP_VERIFY")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("insecure_skip_verify"), "Invalid Qbee API TLS setting",
+			"the QBEE_INSECURE_SKIP_VERIFY environment variable must be a boolean value: "+err.Error())
+		return
+	}
+
+	readOnly, err := boolValueOrEnv(config.ReadOnly, "QBEE_READ_ONLY")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("read_only"), "Invalid Qbee API read-only setting",
+			"the QBEE_READ_ONLY environment variable must be a boolean value: "+err.Error())
+		return
+	}
+
+	batchCommits, err := boolValueOrEnv(config.BatchCommits, "QBEE_BATCH_COMMITS")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("batch_commits"), "Invalid Qbee API batch setting",
+			"the QBEE_BATCH_COMMITS environment variable must be a boolean value: "+err.Error())
+		return
+	}
+
+	batchWindow, err := int64ValueOrEnv(config.BatchWindow, "QBEE_BATCH_WINDOW", int64(defaultBatchWindow.Seconds()))
+	if err != nil || batchWindow < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("batch_window"), "Invalid Qbee API batch setting",
+			"the QBEE_BATCH_WINDOW environment variable must be a number of seconds of at least 1")
+		return
+	}
+
+	maxConcurrency, err := int64ValueOrEnv(config.MaxConcurrency, "QBEE_MAX_CONCURRENCY", defaultMaxConcurrency)
+	if err != nil || maxConcurrency < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_concurrency"), "Invalid Qbee API concurrency setting",
+			"the QBEE_MAX_CONCURRENCY environment variable must be a number of at least 1")
+		return
+	}
 
-	if baseUrl != "" {
-		qbeeClient.Client = qbeeClient.WithBaseURL(baseUrl)
+	retry, diags := retryConfigFromModel(config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := qbeeClient.Authenticate(ctx, username, password)
+	httpClient, err := newHTTPClient(ctx, httpClientConfig{
+		CACertFile:         stringValueOrEnv(config.CACertFile, "QBEE_CA_CERT_FILE"),
+		CACertPEM:          stringValueOrEnv(config.CACertPEM, "QBEE_CA_CERT_PEM"),
+		InsecureSkipVerify: insecureSkipVerify,
+		ProxyURL:           stringValueOrEnv(config.ProxyURL, "QBEE_PROXY_URL"),
+		ClientCertFile:     stringValueOrEnv(config.ClientCertFile, "QBEE_CLIENT_CERT_FILE"),
+		ClientKeyFile:      stringValueOrEnv(config.ClientKeyFile, "QBEE_CLIENT_KEY_FILE"),
+		ClientCertPEM:      stringValueOrEnv(config.ClientCertPEM, "QBEE_CLIENT_CERT_PEM"),
+		ClientKeyPEM:       stringValueOrEnv(config.ClientKeyPEM, "QBEE_CLIENT_KEY_PEM"),
+		Retry:              retry,
+	})
 	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create Qbee API Client",
+			"An error occurred when configuring the HTTP client of the Qbee API client: "+err.Error())
+		return
+	}
+
+	defaultTimeouts, diags := config.DefaultTimeouts.operationTimeouts()
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	qbeeClient := NewClient(httpClient, baseUrl)
+	qbeeClient.defaultTimeouts = defaultTimeouts
+	qbeeClient.readOnly = readOnly
+	qbeeClient.limiter = newOperationLimiter(int(maxConcurrency))
+
+	if batchCommits {
+		qbeeClient.batcher = newCommitBatcher(qbeeClient.CommitConfiguration, time.Duration(batchWindow)*time.Second,
+			max(defaultTimeouts.Create, defaultTimeouts.Update, defaultTimeouts.Delete))
+	}
+
+	// A pre-issued API token replaces the login step entirely
+	if apiToken != "" {
+		qbeeClient.setAPIToken(apiToken)
+	} else if err := qbeeClient.login(ctx, credentials{username: username, password: password, totpSecret: totpSecret}); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create Qbee API Client",
 			"An unexpected error occurred when creating the Qbee API client: "+err.Error())
@@ -138,7 +428,10 @@ func (p *QbeeProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 func (p *QbeeProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewConfigurationBundleResource,
 		NewConnectivityWatchdogResource,
+		NewDeviceAttributesResource,
+		NewDockerComposeResource,
 		NewDockerContainersResource,
 		NewFiledistributionResource,
 		NewFilemanagerDirectoryResource,
@@ -146,11 +439,14 @@ func (p *QbeeProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewFirewallResource,
 		NewGrouptreeGroupResource,
 		NewMetricsMonitorResource,
+		NewNTPResource,
 		NewPackageManagementResource,
 		NewParametersResource,
 		NewPasswordResource,
+		NewPendingDeviceApprovalResource,
 		NewPodmanContainersResource,
 		NewProcessWatchResource,
+		NewProxyResource,
 		NewRaucResource,
 		NewSSHKeysResource,
 		NewSettingsResource,
@@ -162,7 +458,87 @@ func (p *QbeeProvider) Resources(ctx context.Context) []func() resource.Resource
 }
 
 func (p *QbeeProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewActiveConfigDataSource,
+		NewConfigCommitsDataSource,
+		NewDeviceDataSource,
+		NewDeviceInventoryDataSource,
+		NewDevicesDataSource,
+		NewGrouptreeDataSource,
+		NewGrouptreeNodeDataSource,
+	}
+}
+
+// stringValueOrEnv returns the configured value, or the value of the environment variable if it is not set.
+func stringValueOrEnv(value types.String, envVar string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(envVar)
+}
+
+// boolValueOrEnv returns the configured value, or the value of the environment variable if it is not set.
+func boolValueOrEnv(value types.Bool, envVar string) (bool, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool(), nil
+	}
+
+	envValue := os.Getenv(envVar)
+	if envValue == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(envValue)
+}
+
+// int64ValueOrEnv returns the configured value, or the value of the environment variable if it is not set.
+// If neither is set, the default value is returned.
+func int64ValueOrEnv(value types.Int64, envVar string, defaultValue int64) (int64, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueInt64(), nil
+	}
+
+	envValue := os.Getenv(envVar)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	return strconv.ParseInt(envValue, 10, 64)
+}
+
+// retryConfigFromModel returns the retry configuration from the provider model and environment variables.
+func retryConfigFromModel(config qbeeProviderModel) (retryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	maxRetries, err := int64ValueOrEnv(config.MaxRetries, "QBEE_MAX_RETRIES", defaultMaxRetries)
+	if err != nil || maxRetries < 0 {
+		diags.AddAttributeError(path.Root("max_retries"), "Invalid Qbee API retry setting",
+			"the QBEE_MAX_RETRIES environment variable must be a non-negative integer")
+	}
+
+	waitMin, err := int64ValueOrEnv(config.RetryWaitMin, "QBEE_RETRY_WAIT_MIN", int64(defaultRetryWaitMin.Seconds()))
+	if err != nil || waitMin < 0 {
+		diags.AddAttributeError(path.Root("retry_wait_min"), "Invalid Qbee API retry setting",
+			"the QBEE_RETRY_WAIT_MIN environment variable must be a non-negative integer")
+	}
+
+	waitMax, err := int64ValueOrEnv(config.RetryWaitMax, "QBEE_RETRY_WAIT_MAX", int64(defaultRetryWaitMax.Seconds()))
+	if err != nil || waitMax < 0 {
+		diags.AddAttributeError(path.Root("retry_wait_max"), "Invalid Qbee API retry setting",
+			"the QBEE_RETRY_WAIT_MAX environment variable must be a non-negative integer")
+	}
+
+	if waitMin > waitMax {
+		diags.AddAttributeError(path.Root("retry_wait_min"), "Invalid Qbee API retry setting",
+			"retry_wait_min must not be greater than retry_wait_max")
+	}
+
+	return retryConfig{
+		MaxRetries: int(maxRetries),
+		WaitMin:    time.Duration(waitMin) * time.Second,
+		WaitMax:    time.Duration(waitMax) * time.Second,
+	}, diags
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.qbee.io/client/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resourceModelManager                  = &proxyResourceModel{}
+	_ resource.Resource                     = &proxyResource{}
+	_ resource.ResourceWithConfigure        = &proxyResource{}
+	_ resource.ResourceWithConfigValidators = &proxyResource{}
+	_ resource.ResourceWithImportState      = &proxyResource{}
+	_ resource.ResourceWithModifyPlan       = &proxyResource{}
+)
+
+const (
+	errorWritingProxy = "error writing proxy resource"
+	errorReadingProxy = "error reading proxy resource"
+)
+
+// NewProxyResource is a helper function to simplify the provider implementation.
+func NewProxyResource() resource.Resource {
+	return &proxyResource{
+		configurationResource: configurationResource{
+			resourceBase: newResourceBase(config.ProxyBundle),
+			modelFactory: func() any {
+				return new(proxyResourceModel)
+			},
+		},
+	}
+}
+
+type proxyResource struct {
+	configurationResource
+}
+
+// Like for the secrets of the parameters resource, we use private state to detect changes in the
+// write-only password when no password_wo_version is set. The password read from qbee may be masked,
+// so it can't be compared, and only a keyed hash of the password we write is kept.
+type proxyPrivateStateModel struct {
+	// HashKey is the random key of the resource with which the password is hashed, so that the hash
+	// can't be looked up or compared with the hashes of other resources.
+	HashKey string `json:"hash_key"`
+
+	// PasswordWoValueHash is the HMAC-SHA256 of the write-only password (password_wo) from the
+	// Terraform configuration, as last written. It is empty if no password was written.
+	PasswordWoValueHash string `json:"password_wo_value_hash"`
+}
+
+// Schema defines the schema for the resource.
+func (r *proxyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Proxy configures the HTTP proxy used by the qbee agent and the package managers of the system.",
+		Attributes: map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The tag for which to set the configuration. Either tag or node is required.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"node": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The node for which to set the configuration. Either tag or node is required.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"extend": schema.BoolAttribute{
+				Required: true,
+				Description: "If the configuration should extend configuration from the parent nodes of the node " +
+					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
+			},
+			"enabled": enabledAttribute(),
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "The hostname or IP address of the proxy server.",
+			},
+			"port": schema.Int64Attribute{
+				Required:    true,
+				Description: "The port of the proxy server.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional:    true,
+				Description: "The username used to authenticate with the proxy server.",
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Description: "The password used to authenticate with the proxy server. This value is write-only " +
+					"and will not be stored or returned in the state.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional: true,
+				Description: "Optional version for password_wo. If set, the password is only rewritten when this version " +
+					"changes. Otherwise, it is rewritten when password_wo changes. Changes of the password made outside " +
+					"of Terraform are not detected.",
+			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
+		},
+	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes, "password_wo_version")
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
+}
+
+type proxyResourceModel struct {
+	configurationResourceModel
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int64  `tfsdk:"port"`
+	User              types.String `tfsdk:"user"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+
+	// password is the password written to qbee. It is either the write-only password from the
+	// configuration if writePassword is set, or the password currently stored in qbee.
+	password      string
+	writePassword bool
+}
+
+func (m proxyResourceModel) getConfigBundle() config.Bundle {
+	return config.ProxyBundle
+}
+
+func (m *proxyResourceModel) fromBundleData(bundleData config.BundleData) error {
+	data := bundleData.Proxy
+	if data == nil {
+		return fmt.Errorf("proxy bundle data is nil")
+	}
+
+	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
+	m.Host = types.StringValue(data.Host)
+
+	if data.Port != "" {
+		port, err := strconv.ParseInt(data.Port, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid proxy port %q: %w", data.Port, err)
+		}
+
+		m.Port = types.Int64Value(port)
+	}
+
+	if data.User != "" {
+		m.User = types.StringValue(data.User)
+	}
+
+	// The password is write-only, and may be returned masked by qbee, so it is not read
+
+	return nil
+}
+
+func (m proxyResourceModel) toBundleData(metadata config.Metadata) any {
+	bundleData := config.Proxy{
+		Metadata: metadata,
+	}
+
+	if metadata.Reset {
+		return bundleData
+	}
+
+	bundleData.Host = m.Host.ValueString()
+	bundleData.Port = strconv.FormatInt(m.Port.ValueInt64(), 10)
+	bundleData.User = m.User.ValueString()
+	bundleData.Password = m.password
+
+	return bundleData
+}
+
+// keepPassword sets the password to write to the password of the current proxy configuration.
+// A change replaces the whole bundle, so the stored password has to be written again to keep it.
+func (m *proxyResourceModel) keepPassword(current *config.Proxy) {
+	m.password = ""
+	if current != nil {
+		m.password = current.Password
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *proxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.checkReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Combine plan and configuration, since write-only values are not in the plan.
+	var model proxyResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &model.PasswordWo)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := model.Timeouts.Create(ctx, r.client.defaultTimeouts.Create)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	model.writePassword = !model.PasswordWo.IsNull()
+
+	private, diags := r.writeProxy(ctx, &model, proxyPrivateStateModel{})
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateStateKey, private)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	effective, diags := r.readEffective(ctx, resp.State, &model)
+	resp.Diagnostics.Append(warnOnError(diags)...)
+	model.setEffective(effective)
+
+	// The write-only password must not be stored in the state
+	model.PasswordWo = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *proxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
+	// Combine plan and configuration, since write-only values are not in the plan.
+	var model, state proxyResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &model.PasswordWo)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateStateBytes, diags := req.Private.GetKey(ctx, privateStateKey)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateState, diags := unmarshalProxyPrivateState(privateStateBytes)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A change of the password only shows up in the plan as a new commit
+	model.writePassword = passwordChanged(model, state, privateState)
+	if !model.writePassword && onlyCommitMessageChanged(req.Plan, req.State) {
+		keepConfiguration(ctx, req, resp)
+		return
+	}
+
+	updateTimeout, diags := model.Timeouts.Update(ctx, r.client.defaultTimeouts.Update)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	private, diags := r.writeProxy(ctx, &model, privateState)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateStateKey, private)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	effective, diags := r.readEffective(ctx, resp.State, &model)
+	resp.Diagnostics.Append(warnOnError(diags)...)
+	model.setEffective(effective)
+
+	// The write-only password must not be stored in the state
+	model.PasswordWo = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *proxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.configurationResource.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() || resp.State.Raw.IsNull() {
+		return
+	}
+
+	// The password version is not part of the bundle data, so we keep it from the prior state
+	var passwordWoVersion types.Int64
+	if resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password_wo_version"), &passwordWoVersion)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("password_wo_version"), passwordWoVersion)...)
+}
+
+func (r *proxyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If either Plan or State is null, nothing to do (no resource instance)
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state, configuration proxyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configuration)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateStateBytes, diags := req.Private.GetKey(ctx, privateStateKey)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateState, diags := unmarshalProxyPrivateState(privateStateBytes)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !passwordChanged(configuration, state, privateState) {
+		return
+	}
+
+	// Updating the password creates a new commit
+	plan.LastCommitSHA = types.StringUnknown()
+	plan.LastCommitCreated = types.Int64Unknown()
+	plan.Effective = types.ObjectUnknown(plan.Effective.AttributeTypes(ctx))
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// writeProxy commits the proxy configuration of the model and returns the new private state.
+// The password is only changed if writePassword is set on the model; otherwise the password
+// currently stored in qbee is written again.
+func (r *proxyResource) writeProxy(
+	ctx context.Context,
+	model *proxyResourceModel,
+	privateState proxyPrivateStateModel,
+) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if model.writePassword {
+		model.password = model.PasswordWo.ValueString()
+	} else {
+		currentProxy, err := r.readOwnProxy(ctx, model)
+		if err != nil {
+			diags.AddError(errorReadingProxy, "error reading the active configuration: "+err.Error())
+			return nil, diags
+		}
+
+		model.keepPassword(currentProxy)
+	}
+
+	commit, err := r.client.commitConfiguration(ctx, model, false)
+	if err != nil {
+		diags.AddError(errorWritingProxy, "error creating a commit for the proxy: "+err.Error())
+		return nil, diags
+	}
+
+	model.setLastCommit(commit)
+
+	if privateState.HashKey == "" {
+		privateState.HashKey = rand.Text()
+	}
+
+	if model.writePassword {
+		privateState.PasswordWoValueHash = ""
+		if model.password != "" {
+			privateState.PasswordWoValueHash = computePasswordHash(privateState.HashKey, model.password)
+		}
+	}
+
+	private, err := json.Marshal(privateState)
+	if err != nil {
+		diags.AddError(errorWritingProxy, fmt.Sprintf("error marshaling private state: %v", err))
+		return nil, diags
+	}
+
+	return private, diags
+}
+
+// passwordChanged returns whether the password of the configuration must be written, given the prior
+// state and the private state of the resource.
+func passwordChanged(configuration, state proxyResourceModel, privateState proxyPrivateStateModel) bool {
+	// If a password_wo_version is specified, only update when the version changes
+	if !configuration.PasswordWoVersion.IsNull() {
+		return !configuration.PasswordWoVersion.Equal(state.PasswordWoVersion)
+	}
+
+	// No password configured: clear the password we wrote before, if any
+	if configuration.PasswordWo.IsNull() {
+		return privateState.PasswordWoValueHash != ""
+	}
+
+	// Without a key, for example after an import, the last written password is not known
+	if privateState.HashKey == "" {
+		return true
+	}
+
+	passwordHash := computePasswordHash(privateState.HashKey, configuration.PasswordWo.ValueString())
+
+	return !hmac.Equal([]byte(passwordHash), []byte(privateState.PasswordWoValueHash))
+}
+
+// readOwnProxy reads the proxy configuration set on the node or tag of the model itself.
+func (r *proxyResource) readOwnProxy(ctx context.Context, model *proxyResourceModel) (*config.Proxy, error) {
+	activeConfig, err := r.client.GetActiveConfig(ctx, model.getEntityType(), model.getEntityID(), config.EntityConfigScopeOwn)
+	if err != nil {
+		return nil, err
+	}
+
+	return activeConfig.BundleData.Proxy, nil
+}
+
+func unmarshalProxyPrivateState(private []byte) (proxyPrivateStateModel, diag.Diagnostics) {
+	if private == nil {
+		return proxyPrivateStateModel{}, nil
+	}
+
+	var p proxyPrivateStateModel
+	if err := json.Unmarshal(private, &p); err != nil {
+		return proxyPrivateStateModel{}, diag.Diagnostics{
+			diag.NewErrorDiagnostic(errorReadingProxy, "error reading the private state: "+err.Error()),
+		}
+	}
+
+	return p, nil
+}
+
+// computePasswordHash returns the HMAC-SHA256 of the password with the given key.
+func computePasswordHash(key, password string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(password))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
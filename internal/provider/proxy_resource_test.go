@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.qbee.io/client/config"
+)
+
+func TestAccProxyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "qbee_proxy" "test" {
+  tag = "terraform:acctest:proxy"
+  extend = true
+  read_effective = true
+  host = "proxy.example.com"
+  port = 3128
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_proxy.test", "tag", "terraform:acctest:proxy"),
+					resource.TestCheckNoResourceAttr("qbee_proxy.test", "node"),
+					resource.TestCheckResourceAttr("qbee_proxy.test", "extend", "true"),
+					resource.TestCheckResourceAttr("qbee_proxy.test", "host", "proxy.example.com"),
+					resource.TestCheckResourceAttr("qbee_proxy.test", "port", "3128"),
+					resource.TestCheckNoResourceAttr("qbee_proxy.test", "user"),
+					resource.TestCheckResourceAttr("qbee_proxy.test", "effective.host", "proxy.example.com"),
+				),
+			},
+			// Update with credentials
+			{
+				Config: providerConfig + `
+resource "qbee_proxy" "test" {
+  tag = "terraform:acctest:proxy"
+  extend = false
+  host = "proxy.example.com"
+  port = 8080
+  user = "qbee"
+  password_wo = "proxy-password"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_proxy.test", "extend", "false"),
+					resource.TestCheckResourceAttr("qbee_proxy.test", "port", "8080"),
+					resource.TestCheckResourceAttr("qbee_proxy.test", "user", "qbee"),
+					resource.TestCheckNoResourceAttr("qbee_proxy.test", "password_wo"),
+				),
+			},
+			// Changing the password updates the resource
+			{
+				Config: providerConfig + `
+resource "qbee_proxy" "test" {
+  tag = "terraform:acctest:proxy"
+  extend = false
+  host = "proxy.example.com"
+  port = 8080
+  user = "qbee"
+  password_wo = "proxy-password-2"
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// With a version, the password is only written when the version changes
+			{
+				Config: providerConfig + `
+resource "qbee_proxy" "test" {
+  tag = "terraform:acctest:proxy"
+  extend = false
+  host = "proxy.example.com"
+  port = 8080
+  user = "qbee"
+  password_wo = "proxy-password-2"
+  password_wo_version = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("qbee_proxy.test", "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "qbee_proxy" "test" {
+  tag = "terraform:acctest:proxy"
+  extend = false
+  host = "proxy.example.com"
+  port = 8080
+  user = "qbee"
+  password_wo = "proxy-password-3"
+  password_wo_version = 1
+}
+`,
+				PlanOnly: true,
+			},
+			// Import tag
+			{
+				ResourceName:                         "qbee_proxy.test",
+				ImportState:                          true,
+				ImportStateId:                        "tag:terraform:acctest:proxy",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore: []string{
+					"password_wo_version",
+					"last_commit_sha",
+					"last_commit_created",
+				},
+			},
+			// Update to be for a node without a password
+			{
+				Config: providerConfig + `
+resource "qbee_proxy" "test" {
+  node = "integrationtests"
+  extend = true
+  host = "10.0.0.1"
+  port = 3128
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("qbee_proxy.test", "tag"),
+					resource.TestCheckResourceAttr("qbee_proxy.test", "node", "integrationtests"),
+					resource.TestCheckResourceAttr("qbee_proxy.test", "host", "10.0.0.1"),
+				),
+			},
+			// Import node
+			{
+				ResourceName:                         "qbee_proxy.test",
+				ImportState:                          true,
+				ImportStateId:                        "node:integrationtests",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "node",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
+			},
+		},
+	})
+}
+
+func TestProxyResourceModelBundleData(t *testing.T) {
+	model := proxyResourceModel{
+		Host:          types.StringValue("proxy.example.com"),
+		Port:          types.Int64Value(3128),
+		User:          types.StringValue("qbee"),
+		password:      "proxy-password",
+		writePassword: true,
+	}
+
+	bundleData := model.toBundleData(config.Metadata{Enabled: true, Version: "v1"}).(config.Proxy)
+	if bundleData.Port != "3128" || bundleData.Password != "proxy-password" {
+		t.Errorf("toBundleData() = %+v, want port 3128 and the password", bundleData)
+	}
+
+	var read proxyResourceModel
+	if err := read.fromBundleData(config.BundleData{Proxy: &bundleData}); err != nil {
+		t.Fatalf("fromBundleData() error = %v", err)
+	}
+
+	if read.Host != model.Host || read.Port != model.Port || read.User != model.User {
+		t.Errorf("fromBundleData() = %s:%s@%s, want %s:%s@%s",
+			read.User, read.Host, read.Port, model.User, model.Host, model.Port)
+	}
+
+	// Changing only the port writes the password stored in qbee again, as a change replaces the whole bundle
+	model.Port = types.Int64Value(8080)
+	model.writePassword = false
+	model.keepPassword(&config.Proxy{Host: "proxy.example.com", Port: "3128", User: "qbee", Password: "stored-password"})
+
+	bundleData = model.toBundleData(config.Metadata{Enabled: true, Version: "v1"}).(config.Proxy)
+	if bundleData.Port != "8080" || bundleData.Password != "stored-password" {
+		t.Errorf("toBundleData() = %+v, want port 8080 and the stored password", bundleData)
+	}
+
+	// Without a proxy configuration in qbee, there is no password to keep
+	model.keepPassword(nil)
+	if bundleData := model.toBundleData(config.Metadata{Enabled: true, Version: "v1"}).(config.Proxy); bundleData.Password != "" {
+		t.Errorf("toBundleData() = %+v, want no password", bundleData)
+	}
+}
+
+func TestProxyPasswordChanged(t *testing.T) {
+	privateState := proxyPrivateStateModel{HashKey: "key"}
+	privateState.PasswordWoValueHash = computePasswordHash(privateState.HashKey, "proxy-password")
+
+	tests := []struct {
+		name          string
+		configuration proxyResourceModel
+		state         proxyResourceModel
+		privateState  proxyPrivateStateModel
+		want          bool
+	}{
+		{
+			name:          "same password",
+			configuration: proxyResourceModel{PasswordWo: types.StringValue("proxy-password")},
+			privateState:  privateState,
+		},
+		{
+			name:          "new password",
+			configuration: proxyResourceModel{PasswordWo: types.StringValue("proxy-password-2")},
+			privateState:  privateState,
+			want:          true,
+		},
+		{
+			name:          "password without key",
+			configuration: proxyResourceModel{PasswordWo: types.StringValue("proxy-password")},
+			want:          true,
+		},
+		{
+			name:          "password removed",
+			configuration: proxyResourceModel{PasswordWo: types.StringNull()},
+			privateState:  privateState,
+			want:          true,
+		},
+		{
+			name:          "no password",
+			configuration: proxyResourceModel{PasswordWo: types.StringNull()},
+			privateState:  proxyPrivateStateModel{HashKey: "key"},
+		},
+		{
+			name: "same version",
+			configuration: proxyResourceModel{
+				PasswordWo:        types.StringValue("proxy-password-2"),
+				PasswordWoVersion: types.Int64Value(1),
+			},
+			state:        proxyResourceModel{PasswordWoVersion: types.Int64Value(1)},
+			privateState: privateState,
+		},
+		{
+			name: "new version",
+			configuration: proxyResourceModel{
+				PasswordWo:        types.StringValue("proxy-password"),
+				PasswordWoVersion: types.Int64Value(2),
+			},
+			state:        proxyResourceModel{PasswordWoVersion: types.Int64Value(1)},
+			privateState: privateState,
+			want:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := passwordChanged(tt.configuration, tt.state, tt.privateState); got != tt.want {
+				t.Errorf("passwordChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The hash depends on the key of the resource
+	if computePasswordHash("key", "proxy-password") == computePasswordHash("other-key", "proxy-password") {
+		t.Error("computePasswordHash() is the same for different keys")
+	}
+}
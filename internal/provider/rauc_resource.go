@@ -38,7 +38,7 @@ type raucResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *raucResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *raucResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Rauc configures an A/B system update using RAUC.",
 		Attributes: map[string]schema.Attribute{
@@ -57,6 +57,7 @@ func (r *raucResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"pre_condition": schema.StringAttribute{
 				Optional:    true,
 				Description: "An optional command which needs to return 0 in order for RAUC bundle to be installed.",
@@ -65,8 +66,15 @@ func (r *raucResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Description: "The RAUC bundle to be installed.",
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type raucResourceModel struct {
@@ -86,6 +94,7 @@ func (m *raucResourceModel) fromBundleData(bundleData config.BundleData) error {
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	m.RaucBundle = types.StringValue(data.RaucBundle)
 	if data.PreCondition != "" {
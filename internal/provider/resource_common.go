@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"go.qbee.io/client/config"
 )
@@ -37,3 +38,19 @@ func (r *resourceBase) Configure(_ context.Context, req resource.ConfigureReques
 func (r *resourceBase) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, r.name)
 }
+
+// checkReadOnly adds an error to the diagnostics and returns true if the provider is configured in read-only mode.
+// It must be called at the start of every operation that changes resources (create, update and delete).
+func (r *resourceBase) checkReadOnly(operation string, diags *diag.Diagnostics) bool {
+	if r.client == nil || !r.client.readOnly {
+		return false
+	}
+
+	diags.AddError(
+		fmt.Sprintf("Unable to %s %s", operation, r.name),
+		fmt.Sprintf("The provider is configured in read-only mode, so %s resources can not be changed. "+
+			"Unset the read_only provider attribute (QBEE_READ_ONLY) to apply changes.", r.name),
+	)
+
+	return true
+}
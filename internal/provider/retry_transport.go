@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+// retryConfig defines how failed requests to the Qbee API are retried.
+type retryConfig struct {
+	// MaxRetries is the maximum number of retries of a single request. Zero disables retries.
+	MaxRetries int
+
+	// WaitMin is the backoff before the first retry. It doubles with each following retry.
+	WaitMin time.Duration
+
+	// WaitMax is the upper bound of the backoff, including delays requested by the server with Retry-After.
+	WaitMax time.Duration
+}
+
+// retryTransport is an http.RoundTripper that retries requests failing with transient errors using exponential backoff.
+//
+// Idempotent requests (GET, HEAD, OPTIONS) are retried on network errors, 429 and 5xx responses.
+// Other requests, such as configuration commits, are only retried when the API responded with
+// 429 Too Many Requests or 503 Service Unavailable, as the request was not processed in those cases.
+type retryTransport struct {
+	next   http.RoundTripper
+	config retryConfig
+}
+
+// newRetryTransport wraps the given transport with retries according to the given configuration.
+func newRetryTransport(next http.RoundTripper, config retryConfig) *retryTransport {
+	return &retryTransport{
+		next:   next,
+		config: config,
+	}
+}
+
+// RoundTrip executes the request, retrying it when it fails with a retryable error.
+func (t *retryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	attemptRequest := request
+
+	for attempt := 0; ; attempt++ {
+		// Each retry sends a copy of the original request with a fresh body
+		if attempt > 0 {
+			attemptRequest = request.Clone(ctx)
+
+			if request.Body != nil {
+				body, err := request.GetBody()
+				if err != nil {
+					return nil, err
+				}
+
+				attemptRequest.Body = body
+			}
+		}
+
+		response, err := t.next.RoundTrip(attemptRequest)
+		if attempt >= t.config.MaxRetries || !isRetryable(request, response, err) {
+			return response, err
+		}
+
+		wait := t.backoff(attempt, response)
+
+		fields := map[string]any{
+			"method":      request.Method,
+			"url":         request.URL.Redacted(),
+			"attempt":     attempt + 1,
+			"max_retries": t.config.MaxRetries,
+			"wait":        wait.String(),
+		}
+
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = response.StatusCode
+
+			// Drain and close the body, so the connection can be reused by the next attempt
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+
+		tflog.Warn(ctx, "Retrying Qbee API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// isRetryable returns true if the request can safely be sent again after the given response or error.
+func isRetryable(request *http.Request, response *http.Response, err error) bool {
+	// Requests with a body that can't be replayed, like streamed file uploads, are never retried
+	if request.Body != nil && request.GetBody == nil {
+		return false
+	}
+
+	// Don't retry when the request was cancelled or timed out on our side
+	if request.Context().Err() != nil {
+		return false
+	}
+
+	idempotent := request.Method == http.MethodGet ||
+		request.Method == http.MethodHead ||
+		request.Method == http.MethodOptions
+
+	if err != nil {
+		return idempotent
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	default:
+		return false
+	}
+}
+
+// backoff returns how long to wait before the next attempt.
+// A delay requested by the server with the Retry-After header takes precedence over the exponential backoff.
+func (t *retryTransport) backoff(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if wait, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+			return min(wait, t.config.WaitMax)
+		}
+	}
+
+	// Double the wait as long as it stays within the maximum, checked before shifting so it can not overflow.
+	// A minimum wait of 0 disables the wait between retries.
+	wait := t.config.WaitMax
+	if attempt < 63 && t.config.WaitMin <= t.config.WaitMax>>attempt {
+		wait = t.config.WaitMin << attempt
+	}
+
+	// Add jitter, so concurrent resources don't retry in lockstep
+	if half := int64(wait / 2); half > 0 {
+		wait = time.Duration(half + rand.Int64N(half+1))
+	}
+
+	return wait
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestRetryClient returns an HTTP client that retries with negligible backoff.
+func newTestRetryClient(maxRetries int) *http.Client {
+	return &http.Client{
+		Transport: newRetryTransport(http.DefaultTransport, retryConfig{
+			MaxRetries: maxRetries,
+			WaitMin:    time.Millisecond,
+			WaitMax:    10 * time.Millisecond,
+		}),
+	}
+}
+
+// newStatusServer returns a test server responding with the given status codes in order,
+// followed by 200 OK, and a counter of the received requests.
+func newStatusServer(t *testing.T, statusCodes ...int) (*httptest.Server, *atomic.Int32) {
+	calls := new(atomic.Int32)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1))
+
+		// Make sure the request body is replayed on each attempt
+		if r.Body != nil {
+			if body, _ := io.ReadAll(r.Body); r.Method == http.MethodPost && string(body) != "payload" {
+				t.Errorf("unexpected request body on attempt %d: %q", call, body)
+			}
+		}
+
+		if call <= len(statusCodes) {
+			w.WriteHeader(statusCodes[call-1])
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, calls
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		statusCodes []int
+		maxRetries  int
+		wantStatus  int
+		wantCalls   int32
+	}{
+		{
+			name:        "read retried on 5xx",
+			method:      http.MethodGet,
+			statusCodes: []int{http.StatusBadGateway, http.StatusServiceUnavailable},
+			maxRetries:  3,
+			wantStatus:  http.StatusOK,
+			wantCalls:   3,
+		},
+		{
+			name:        "read gives up after max retries",
+			method:      http.MethodGet,
+			statusCodes: []int{500, 500, 500, 500},
+			maxRetries:  2,
+			wantStatus:  http.StatusInternalServerError,
+			wantCalls:   3,
+		},
+		{
+			name:        "read not retried on client error",
+			method:      http.MethodGet,
+			statusCodes: []int{http.StatusNotFound},
+			maxRetries:  3,
+			wantStatus:  http.StatusNotFound,
+			wantCalls:   1,
+		},
+		{
+			name:        "commit retried when rate limited",
+			method:      http.MethodPost,
+			statusCodes: []int{http.StatusTooManyRequests},
+			maxRetries:  3,
+			wantStatus:  http.StatusOK,
+			wantCalls:   2,
+		},
+		{
+			name:        "commit not retried on internal server error",
+			method:      http.MethodPost,
+			statusCodes: []int{http.StatusInternalServerError},
+			maxRetries:  3,
+			wantStatus:  http.StatusInternalServerError,
+			wantCalls:   1,
+		},
+		{
+			name:        "retries disabled",
+			method:      http.MethodGet,
+			statusCodes: []int{http.StatusServiceUnavailable},
+			maxRetries:  0,
+			wantStatus:  http.StatusServiceUnavailable,
+			wantCalls:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newStatusServer(t, tt.statusCodes...)
+
+			var body io.Reader
+			if tt.method == http.MethodPost {
+				body = bytes.NewReader([]byte("payload"))
+			}
+
+			request, err := http.NewRequest(tt.method, server.URL, body)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			response, err := newTestRetryClient(tt.maxRetries).Do(request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			_ = response.Body.Close()
+
+			if response.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", response.StatusCode, tt.wantStatus)
+			}
+
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryTransportStreamedBodyNotRetried(t *testing.T) {
+	server, calls := newStatusServer(t, http.StatusServiceUnavailable)
+
+	// A request body without GetBody can't be replayed
+	request, _ := http.NewRequest(http.MethodPost, server.URL, io.NopCloser(bytes.NewReader([]byte("payload"))))
+	request.GetBody = nil
+
+	response, err := newTestRetryClient(3).Do(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = response.Body.Close()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("got %d calls, want 1", got)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := newRetryTransport(nil, retryConfig{
+		MaxRetries: 5,
+		WaitMin:    time.Second,
+		WaitMax:    10 * time.Second,
+	})
+
+	for attempt, maxWait := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second} {
+		wait := transport.backoff(attempt, nil)
+		if wait < maxWait/2 || wait > maxWait {
+			t.Errorf("attempt %d: backoff %v not in [%v, %v]", attempt, wait, maxWait/2, maxWait)
+		}
+	}
+
+	response := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if wait := transport.backoff(0, response); wait != 3*time.Second {
+		t.Errorf("got backoff %v, want Retry-After of 3s", wait)
+	}
+
+	response.Header.Set("Retry-After", "120")
+	if wait := transport.backoff(0, response); wait != 10*time.Second {
+		t.Errorf("got backoff %v, want Retry-After capped at 10s", wait)
+	}
+
+	// Attempts far beyond the point where the wait reaches the maximum must not overflow
+	if wait := transport.backoff(70, nil); wait < 5*time.Second || wait > 10*time.Second {
+		t.Errorf("attempt 70: backoff %v not in [5s, 10s]", wait)
+	}
+
+	noWait := newRetryTransport(nil, retryConfig{MaxRetries: 5, WaitMax: 10 * time.Second})
+	for attempt := range 5 {
+		if wait := noWait.backoff(attempt, nil); wait != 0 {
+			t.Errorf("attempt %d: backoff %v with a minimum wait of 0, want 0", attempt, wait)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if wait, ok := parseRetryAfter("5"); !ok || wait != 5*time.Second {
+		t.Errorf("got %v, %v, want 5s", wait, ok)
+	}
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if wait, ok := parseRetryAfter(date); !ok || wait <= 0 || wait > time.Minute {
+		t.Errorf("got %v, %v, want about a minute", wait, ok)
+	}
+
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("expected invalid value to be ignored")
+	}
+}
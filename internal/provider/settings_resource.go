@@ -39,7 +39,7 @@ type settingsResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *settingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *settingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Settings defines agent settings.",
 		Attributes: map[string]schema.Attribute{
@@ -58,6 +58,7 @@ func (r *settingsResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"metrics": schema.BoolAttribute{
 				Required:    true,
 				Description: "Metrics collection enabled.",
@@ -82,8 +83,15 @@ func (r *settingsResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Required:    true,
 				Description: "AgentInterval defines how often agent reports back to the device hub (in minutes).",
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type settingsResourceModel struct {
@@ -107,6 +115,7 @@ func (m *settingsResourceModel) fromBundleData(bundleData config.BundleData) err
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	m.Metrics = types.BoolValue(data.Metrics)
 	m.Reports = types.BoolValue(data.Reports)
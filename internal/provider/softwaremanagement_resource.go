@@ -38,7 +38,7 @@ type softwaremanagementResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *softwaremanagementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *softwaremanagementResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "SoftwareManagement controls software in the system.",
 		Attributes: map[string]schema.Attribute{
@@ -57,6 +57,7 @@ func (r *softwaremanagementResource) Schema(_ context.Context, _ resource.Schema
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"items": schema.ListNestedAttribute{
 				Required:    true,
 				Description: "The filesets that must be distributed",
@@ -110,8 +111,15 @@ func (r *softwaremanagementResource) Schema(_ context.Context, _ resource.Schema
 					},
 				},
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type softwareManagementResourceModel struct {
@@ -148,6 +156,7 @@ func (m *softwareManagementResourceModel) fromBundleData(bundleData config.Bundl
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	for _, softwarePackage := range data.Items {
 		softwarePackageModel := softwareManagementItemModel{
@@ -104,6 +104,7 @@ resource "qbee_softwaremanagement" "test" {
 				ImportStateId:                        "tag:terraform:acctest:softwaremanagement",
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "tag",
+				ImportStateVerifyIgnore:              []string{"last_commit_sha", "last_commit_created"},
 			},
 		},
 	})
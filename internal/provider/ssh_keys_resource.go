@@ -38,7 +38,7 @@ type sshKeysResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *sshKeysResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *sshKeysResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "SSHKeys adds or removes authorized SSH keys for users.",
 		Attributes: map[string]schema.Attribute{
@@ -57,6 +57,7 @@ func (r *sshKeysResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"users": schema.ListNestedAttribute{
 				Required:    true,
 				Description: "The users to set SSH keys for.",
@@ -74,8 +75,15 @@ func (r *sshKeysResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					},
 				},
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type sshKeysResourceModel struct {
@@ -99,6 +107,7 @@ func (m *sshKeysResourceModel) fromBundleData(bundleData config.BundleData) erro
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	for _, user := range data.Users {
 		keys := make([]types.String, 0)
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// totpPeriod is the time step used by authenticator apps (RFC 6238).
+	totpPeriod = 30 * time.Second
+
+	// totpDigits is the number of digits in a generated code.
+	totpDigits = 6
+)
+
+// generateTOTP computes the time-based one-time password (RFC 6238) for the given base32 encoded secret at time t.
+// It uses the HMAC-SHA1, 30 seconds and 6 digits defaults that qbee expects from authenticator apps.
+func generateTOTP(secret string, t time.Time) (string, error) {
+	// Authenticator apps display secrets in groups, lower-case and without padding, so normalize before decoding
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	secret = strings.TrimRight(secret, "=")
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return "", fmt.Errorf("invalid TOTP secret, expected a base32 encoded value: %w", err)
+	}
+
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(t.Unix()/int64(totpPeriod.Seconds())))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation as described in RFC 4226, section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, code%1000000), nil
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGenerateTOTP(t *testing.T) {
+	// Test vectors from RFC 6238, appendix B (SHA1), truncated to 6 digits.
+	// The secret is the ASCII string "12345678901234567890" encoded as base32.
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := generateTOTP(secret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got != tt.want {
+			t.Errorf("generateTOTP(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateTOTPNormalizesSecret(t *testing.T) {
+	got, err := generateTOTP("gezd gnbv gy3t qojq gezd gnbv gy3t qojq", time.Unix(59, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != "287082" {
+		t.Errorf("generateTOTP() = %s, want 287082", got)
+	}
+}
+
+func TestGenerateTOTPInvalidSecret(t *testing.T) {
+	if _, err := generateTOTP("not-base32!", time.Now()); err == nil {
+		t.Error("expected an error for an invalid secret")
+	}
+}
@@ -40,7 +40,7 @@ type usersResource struct {
 }
 
 // Schema defines the schema for the resource.
-func (r *usersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *usersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Users adds or removes users.",
 		Attributes: map[string]schema.Attribute{
@@ -59,6 +59,7 @@ func (r *usersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "If the configuration should extend configuration from the parent nodes of the node " +
 					"the configuration is applied to. If set to false, configuration from parent nodes is ignored.",
 			},
+			"enabled": enabledAttribute(),
 			"users": schema.ListNestedAttribute{
 				Required:    true,
 				Description: "The users to add or remove.",
@@ -78,8 +79,15 @@ func (r *usersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 				},
 			},
+			"commit_message":      commitMessageAttribute(),
+			"last_commit_sha":     lastCommitSHAAttribute(),
+			"last_commit_created": lastCommitCreatedAttribute(),
+			"timeouts":            configurationTimeoutsAttribute(ctx),
 		},
 	}
+
+	resp.Schema.Attributes["effective"] = effectiveAttribute(resp.Schema.Attributes)
+	resp.Schema.Attributes["read_effective"] = readEffectiveAttribute()
 }
 
 type usersResourceModel struct {
@@ -103,6 +111,7 @@ func (m *usersResourceModel) fromBundleData(bundleData config.BundleData) error
 	}
 
 	m.Extend = types.BoolValue(data.Metadata.Extend)
+	m.Enabled = types.BoolValue(data.Metadata.Enabled)
 
 	for _, u := range data.Users {
 		m.Users = append(m.Users, user{